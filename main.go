@@ -3,12 +3,19 @@ package main
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"log"
+	"sync"
 
 	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
 	repository "lambda-go/pkg/repositories"
 	"lambda-go/pkg/routes"
 	adminService "lambda-go/pkg/services/admin"
+	cacheService "lambda-go/pkg/services/cache"
+	eventService "lambda-go/pkg/services/event"
+	licenseService "lambda-go/pkg/services/license"
+	notificationService "lambda-go/pkg/services/notification"
 	publicService "lambda-go/pkg/services/public"
 	"lambda-go/pkg/utils"
 
@@ -18,6 +25,13 @@ import (
 	_ "github.com/lib/pq"
 )
 
+// 분석 결과 캐시는 Lambda 웜 스타트 동안 재사용되도록 요청 간에 공유합니다
+var (
+	analyticsCacheOnce sync.Once
+	analyticsCache     cacheService.Cache
+	analyticsCacheErr  error
+)
+
 // AppErrorToResponse는 AppError를 API Gateway 응답으로 변환합니다
 func appErrorToResponse(err *utils.AppError) events.APIGatewayProxyResponse {
 	response, _ := utils.Error(err.StatusCode, err.Message)
@@ -27,8 +41,9 @@ func appErrorToResponse(err *utils.AppError) events.APIGatewayProxyResponse {
 		response.Headers = make(map[string]string)
 	}
 	response.Headers["Access-Control-Allow-Origin"] = "*"
-	response.Headers["Access-Control-Allow-Headers"] = "Content-Type,X-Amz-Date,Authorization,X-Api-Key,X-Amz-Security-Token"
-	response.Headers["Access-Control-Allow-Methods"] = "GET,POST,OPTIONS"
+	response.Headers["Access-Control-Allow-Headers"] = "Content-Type,X-Amz-Date,Authorization,X-Api-Key,X-Amz-Security-Token,If-Match"
+	response.Headers["Access-Control-Allow-Methods"] = "GET,POST,PUT,PATCH,DELETE,OPTIONS"
+	response.Headers["Access-Control-Expose-Headers"] = "ETag"
 
 	return response
 }
@@ -67,13 +82,55 @@ func handleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (
 	}
 	defer sqlDB.Close()
 
+	txManager := repository.NewTxManager(dbPool)
 	restaurantRepo := repository.NewRestaurantRepository(dbPool)
+	auditRepo := repository.NewAuditRepository(dbPool)
+	rejectReasonRepo := repository.NewRejectReasonRepository(dbPool)
+	notificationRepo := repository.NewNotificationRepository(dbPool)
+	eventRepo := repository.NewEventRepository(dbPool)
+	licenseRepo := repository.NewLicenseRepository(dbPool)
+	tagRepo := repository.NewTagRepository(dbPool)
+	orderRepo := repository.NewOrderRepository(dbPool)
+	analyticsRepo := repository.NewAnalyticsRepository(dbPool)
+	userRepo := repository.NewUserRepository(dbPool)
+	uploadRepo := repository.NewUploadRepository(dbPool)
+
+	// 사업자등록번호 조회 제공자 초기화
+	licenseCfg := cfg.NewLicenseConfig()
+	verifier, err := licenseService.NewVerifier(licenseCfg)
+	if err != nil {
+		log.Printf("사업자등록번호 조회 제공자 초기화 실패: %v", err)
+		appErr := utils.InternalServerError("서버 초기화 중 오류가 발생했습니다", err)
+		return appErrorToResponse(appErr), nil
+	}
+	licenseChecker := licenseService.NewChecker(licenseCfg, verifier, licenseRepo)
+
+	// 분석 결과 캐시 초기화
+	analyticsCfg := cfg.NewAnalyticsConfig()
+	analyticsCacheOnce.Do(func() {
+		analyticsCache, analyticsCacheErr = cacheService.NewCache(analyticsCfg)
+	})
+	if analyticsCacheErr != nil {
+		log.Printf("분석 결과 캐시 초기화 실패: %v", analyticsCacheErr)
+		appErr := utils.InternalServerError("서버 초기화 중 오류가 발생했습니다", analyticsCacheErr)
+		return appErrorToResponse(appErr), nil
+	}
 
-	s3Svc := publicService.NewS3Service(cfg, s3Client, presignClient)
-	adminSvc := adminService.NewRestaurantService(cfg, restaurantRepo)
+	uploadCfg := cfg.NewUploadConfig()
+	s3Svc := publicService.NewS3Service(cfg, uploadCfg, s3Client, presignClient)
+	uploadSvc := publicService.NewUploadService(uploadCfg, uploadRepo, s3Svc)
+	adminSvc := adminService.NewRestaurantService(cfg, txManager, restaurantRepo, rejectReasonRepo, notificationRepo, eventRepo, auditRepo, licenseChecker)
+	auditSvc := adminService.NewAuditService(cfg, auditRepo)
+	rejectReasonSvc := adminService.NewRejectReasonService(cfg, txManager, rejectReasonRepo, auditRepo)
+	tagSvc := adminService.NewTagService(cfg, txManager, tagRepo, restaurantRepo, auditRepo)
+	orderSvc := adminService.NewOrderService(cfg, txManager, orderRepo, restaurantRepo, eventRepo, auditRepo)
+	analyticsSvc := adminService.NewAnalyticsService(cfg, analyticsCfg, analyticsRepo, analyticsCache)
+	userSvc := adminService.NewUserService(cfg, txManager, userRepo, restaurantRepo, orderRepo, eventRepo, auditRepo)
+	exportSvc := adminService.NewExportService(cfg, cfg.NewExportConfig(), txManager, restaurantRepo, orderRepo, s3Svc)
+	importSvc := adminService.NewImportService(cfg, cfg.NewImportConfig(), txManager, restaurantRepo, userRepo, auditRepo, s3Svc)
 
 	// 라우터 설정 및 요청 핸들러 함수 가져오기
-	_, handleFunc := routes.SetupRouter(ctx, cfg, s3Svc, adminSvc, sqlDB)
+	_, handleFunc := routes.SetupRouter(ctx, cfg, s3Svc, uploadSvc, adminSvc, auditSvc, rejectReasonSvc, tagSvc, orderSvc, analyticsSvc, userSvc, exportSvc, importSvc, sqlDB)
 
 	// 요청 처리
 	response, appErr := handleFunc(ctx, request)
@@ -84,6 +141,137 @@ func handleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (
 	return response, nil
 }
 
+// handleNotificationWorker는 스케줄 이벤트로 실행되어 알림 아웃박스의 알림을 발송합니다.
+func handleNotificationWorker(ctx context.Context, event events.CloudWatchEvent) (models.NotificationDispatchResult, error) {
+	cfg := config.NewConfig()
+
+	dbPool, err := pgxpool.Connect(ctx, cfg.NewDBConfig().DatabaseURL)
+	if err != nil {
+		log.Printf("데이터베이스 연결 실패: %v", err)
+		return models.NotificationDispatchResult{}, err
+	}
+	defer dbPool.Close()
+
+	notifyCfg := cfg.NewNotificationConfig()
+	dispatcher := notificationService.NewDispatcher(
+		notifyCfg,
+		repository.NewNotificationRepository(dbPool),
+		repository.NewRejectReasonRepository(dbPool),
+		notificationService.NewChannels(cfg, notifyCfg),
+	)
+
+	result, err := dispatcher.Dispatch(ctx)
+	if err != nil {
+		log.Printf("알림 발송 실패: %v", err)
+		return result, err
+	}
+
+	log.Printf("알림 발송 결과: %+v", result)
+	return result, nil
+}
+
+// handleEventRelay는 스케줄 이벤트로 실행되어 도메인 이벤트 아웃박스의 이벤트를 싱크로 발행합니다.
+func handleEventRelay(ctx context.Context, event events.CloudWatchEvent) (models.EventPublishResult, error) {
+	cfg := config.NewConfig()
+	eventCfg := cfg.NewEventConfig()
+
+	sink, err := eventService.NewSink(ctx, cfg, eventCfg)
+	if err != nil {
+		log.Printf("이벤트 싱크 초기화 실패: %v", err)
+		return models.EventPublishResult{}, err
+	}
+
+	dbPool, err := pgxpool.Connect(ctx, cfg.NewDBConfig().DatabaseURL)
+	if err != nil {
+		log.Printf("데이터베이스 연결 실패: %v", err)
+		return models.EventPublishResult{}, err
+	}
+	defer dbPool.Close()
+
+	relay := eventService.NewRelay(eventCfg, repository.NewEventRepository(dbPool), sink)
+
+	result, err := relay.Relay(ctx)
+	if err != nil {
+		log.Printf("이벤트 발행 실패: %v", err)
+		return result, err
+	}
+
+	log.Printf("이벤트 발행 결과 (%s): %+v", sink.Name(), result)
+	return result, nil
+}
+
+// handleUploadVerifier는 S3 객체 생성 이벤트(EventBridge)로 실행되어 업로드된 파일의 실제 형식을 검증합니다.
+func handleUploadVerifier(ctx context.Context, event events.CloudWatchEvent) (*models.UploadVerificationResult, error) {
+	var detail models.S3ObjectCreatedDetail
+	if err := json.Unmarshal(event.Detail, &detail); err != nil {
+		log.Printf("S3 이벤트 파싱 실패: %v", err)
+		return nil, err
+	}
+
+	cfg := config.NewConfig()
+	s3Client, presignClient, err := config.NewS3Client(ctx, cfg)
+	if err != nil {
+		log.Printf("S3 클라이언트 초기화 실패: %v", err)
+		return nil, err
+	}
+	s3Svc := publicService.NewS3Service(cfg, cfg.NewUploadConfig(), s3Client, presignClient)
+
+	result, err := s3Svc.VerifyUpload(ctx, detail.Bucket.Name, detail.Object.Key)
+	if err != nil {
+		log.Printf("업로드 검증 실패 (s3://%s/%s): %v", detail.Bucket.Name, detail.Object.Key, err)
+		return nil, err
+	}
+
+	log.Printf("업로드 검증 결과: %+v", *result)
+	return result, nil
+}
+
+// handleUploadCleanup은 스케줄 이벤트로 실행되어 확인되지 않았거나 참조되지 않는 업로드 객체를 정리합니다.
+func handleUploadCleanup(ctx context.Context, event events.CloudWatchEvent) (models.UploadCleanupResult, error) {
+	cfg := config.NewConfig()
+
+	s3Client, presignClient, err := config.NewS3Client(ctx, cfg)
+	if err != nil {
+		log.Printf("S3 클라이언트 초기화 실패: %v", err)
+		return models.UploadCleanupResult{}, err
+	}
+
+	dbPool, err := pgxpool.Connect(ctx, cfg.NewDBConfig().DatabaseURL)
+	if err != nil {
+		log.Printf("데이터베이스 연결 실패: %v", err)
+		return models.UploadCleanupResult{}, err
+	}
+	defer dbPool.Close()
+
+	uploadCfg := cfg.NewUploadConfig()
+	uploadSvc := publicService.NewUploadService(
+		uploadCfg,
+		repository.NewUploadRepository(dbPool),
+		publicService.NewS3Service(cfg, uploadCfg, s3Client, presignClient),
+	)
+
+	result, err := uploadSvc.Cleanup(ctx)
+	if err != nil {
+		log.Printf("업로드 정리 실패: %v", err)
+		return result, err
+	}
+
+	log.Printf("업로드 정리 결과: %+v", result)
+	return result, nil
+}
+
 func main() {
-	lambda.Start(handleRequest)
+	// HANDLER_MODE에 따라 API 대신 알림 발송 워커(worker), 이벤트 릴레이(relay), 업로드 검증(verifier), 업로드 정리(cleanup)로 동작
+	switch config.NewConfig().HandlerMode {
+	case "worker":
+		lambda.Start(handleNotificationWorker)
+	case "relay":
+		lambda.Start(handleEventRelay)
+	case "verifier":
+		lambda.Start(handleUploadVerifier)
+	case "cleanup":
+		lambda.Start(handleUploadCleanup)
+	default:
+		lambda.Start(handleRequest)
+	}
 }
@@ -0,0 +1,2 @@
+-- 매장 요청 철회 상태 추가
+ALTER TYPE "RestaurantRequestStatus" ADD VALUE IF NOT EXISTS 'WITHDRAWN';
@@ -0,0 +1,2 @@
+-- 매장 요청 낙관적 동시성 제어용 버전 컬럼
+ALTER TABLE "RestaurantRequest" ADD COLUMN IF NOT EXISTS "version" INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,33 @@
+-- 관리자 행위 감사 로그 (추가 전용)
+CREATE TABLE IF NOT EXISTS "AuditLog" (
+    "id"         BIGSERIAL PRIMARY KEY,
+    "actorId"    TEXT         NOT NULL,
+    "actorRole"  TEXT,
+    "action"     TEXT         NOT NULL,
+    "targetType" TEXT         NOT NULL,
+    "targetId"   TEXT         NOT NULL,
+    "before"     JSONB,
+    "after"      JSONB,
+    "reason"     TEXT,
+    "ip"         TEXT,
+    "userAgent"  TEXT,
+    "requestId"  TEXT,
+    "createdAt"  TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "AuditLog_actorId_idx" ON "AuditLog" ("actorId");
+CREATE INDEX IF NOT EXISTS "AuditLog_target_idx" ON "AuditLog" ("targetType", "targetId");
+CREATE INDEX IF NOT EXISTS "AuditLog_action_idx" ON "AuditLog" ("action");
+CREATE INDEX IF NOT EXISTS "AuditLog_createdAt_idx" ON "AuditLog" ("createdAt");
+
+-- 수정/삭제 차단
+CREATE OR REPLACE FUNCTION "AuditLog_append_only"() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'AuditLog는 추가만 가능합니다';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS "AuditLog_no_update_delete" ON "AuditLog";
+CREATE TRIGGER "AuditLog_no_update_delete"
+    BEFORE UPDATE OR DELETE ON "AuditLog"
+    FOR EACH ROW EXECUTE FUNCTION "AuditLog_append_only"();
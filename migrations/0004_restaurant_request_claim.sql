@@ -0,0 +1,6 @@
+-- 매장 요청 검토자 점유(잠금) 정보
+ALTER TABLE "RestaurantRequest" ADD COLUMN IF NOT EXISTS "assigneeId" TEXT;
+ALTER TABLE "RestaurantRequest" ADD COLUMN IF NOT EXISTS "claimedAt" TIMESTAMP(3);
+ALTER TABLE "RestaurantRequest" ADD COLUMN IF NOT EXISTS "claimExpiresAt" TIMESTAMP(3);
+
+CREATE INDEX IF NOT EXISTS "RestaurantRequest_assigneeId_idx" ON "RestaurantRequest" ("assigneeId");
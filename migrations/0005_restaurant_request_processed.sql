@@ -0,0 +1,11 @@
+-- 매장 요청 검토 결정 정보 (SLA/검토자 통계용)
+ALTER TABLE "RestaurantRequest" ADD COLUMN IF NOT EXISTS "processedBy" TEXT;
+ALTER TABLE "RestaurantRequest" ADD COLUMN IF NOT EXISTS "processedAt" TIMESTAMP(3);
+
+-- 기존 처리 건은 마지막 수정 시간을 처리 시간으로 간주
+UPDATE "RestaurantRequest"
+SET "processedAt" = "updatedAt"
+WHERE "processedAt" IS NULL AND "status" IN ('APPROVED', 'REJECTED');
+
+CREATE INDEX IF NOT EXISTS "RestaurantRequest_processedAt_idx" ON "RestaurantRequest" ("processedAt");
+CREATE INDEX IF NOT EXISTS "RestaurantRequest_status_createdAt_idx" ON "RestaurantRequest" ("status", "createdAt");
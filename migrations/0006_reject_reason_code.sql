@@ -0,0 +1,38 @@
+-- 거절 사유 코드 카탈로그
+CREATE TABLE IF NOT EXISTS "RejectReasonCode" (
+    "code" TEXT PRIMARY KEY,
+    "titleKo" TEXT NOT NULL,
+    "titleEn" TEXT NOT NULL DEFAULT '',
+    "templateKo" TEXT NOT NULL,
+    "templateEn" TEXT NOT NULL DEFAULT '',
+    "active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "sortOrder" INTEGER NOT NULL DEFAULT 0,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO "RejectReasonCode" ("code", "titleKo", "titleEn", "templateKo", "templateEn", "sortOrder")
+VALUES
+    ('LICENSE_UNREADABLE', '사업자등록증 식별 불가', 'Unreadable business license',
+        '사업자등록증 이미지를 확인할 수 없습니다. 선명한 이미지로 다시 제출해 주세요.',
+        'We could not read the business license image. Please resubmit a clear image.', 10),
+    ('LICENSE_NUMBER_MISMATCH', '사업자등록번호 불일치', 'Business number mismatch',
+        '입력한 사업자등록번호가 사업자등록증과 일치하지 않습니다.',
+        'The business registration number does not match the license.', 20),
+    ('ADDRESS_MISMATCH', '주소 불일치', 'Address mismatch',
+        '매장 주소가 사업자등록증의 사업장 소재지와 일치하지 않습니다.',
+        'The restaurant address does not match the address on the business license.', 30),
+    ('OWNER_MISMATCH', '대표자 불일치', 'Owner mismatch',
+        '신청자 정보가 사업자등록증의 대표자와 일치하지 않습니다.',
+        'The applicant does not match the owner on the business license.', 40),
+    ('DUPLICATE_RESTAURANT', '중복 매장', 'Duplicate restaurant',
+        '이미 등록된 매장과 중복된 요청입니다.',
+        'This restaurant is already registered.', 50),
+    ('INSUFFICIENT_INFO', '정보 부족', 'Insufficient information',
+        '매장 정보가 충분하지 않습니다. 필수 정보를 보완해 다시 요청해 주세요.',
+        'Restaurant information is incomplete. Please fill in the required fields and try again.', 60)
+ON CONFLICT ("code") DO NOTHING;
+
+-- 매장 요청의 구조화된 거절 사유
+ALTER TABLE "RestaurantRequest" ADD COLUMN IF NOT EXISTS "rejectReasonCodes" TEXT[];
+ALTER TABLE "RestaurantRequest" ADD COLUMN IF NOT EXISTS "rejectNote" TEXT;
@@ -0,0 +1,21 @@
+-- 점주 알림 트랜잭셔널 아웃박스
+-- 검토 결정과 같은 트랜잭션에서 기록되며, 알림 워커가 커밋된 행만 읽어 발송합니다.
+CREATE TABLE IF NOT EXISTS "NotificationOutbox" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "userId" TEXT NOT NULL,
+    "channel" TEXT NOT NULL,
+    "template" TEXT NOT NULL,
+    "locale" TEXT NOT NULL DEFAULT 'ko',
+    "payload" JSONB NOT NULL,
+    "status" TEXT NOT NULL DEFAULT 'PENDING',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "lastError" TEXT,
+    "nextAttemptAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "sentAt" TIMESTAMP(3)
+);
+
+CREATE INDEX IF NOT EXISTS "NotificationOutbox_pending_idx"
+    ON "NotificationOutbox" ("nextAttemptAt")
+    WHERE "status" = 'PENDING';
+CREATE INDEX IF NOT EXISTS "NotificationOutbox_userId_idx" ON "NotificationOutbox" ("userId");
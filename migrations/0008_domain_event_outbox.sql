@@ -0,0 +1,22 @@
+-- 도메인 이벤트 트랜잭셔널 아웃박스
+-- 상태 변경과 같은 트랜잭션에서 기록되며, 이벤트 릴레이가 커밋된 이벤트를 외부 싱크로 발행합니다.
+CREATE TABLE IF NOT EXISTS "DomainEventOutbox" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "eventId" TEXT NOT NULL UNIQUE, -- 멱등성 키
+    "eventType" TEXT NOT NULL,
+    "aggregateType" TEXT NOT NULL,
+    "aggregateId" TEXT NOT NULL,
+    "actorId" TEXT,
+    "data" JSONB NOT NULL,
+    "occurredAt" TIMESTAMP(3) NOT NULL,
+    "status" TEXT NOT NULL DEFAULT 'PENDING',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "lastError" TEXT,
+    "nextAttemptAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "publishedAt" TIMESTAMP(3)
+);
+
+CREATE INDEX IF NOT EXISTS "DomainEventOutbox_pending_idx"
+    ON "DomainEventOutbox" ("nextAttemptAt", "id")
+    WHERE "status" = 'PENDING';
+CREATE INDEX IF NOT EXISTS "DomainEventOutbox_aggregate_idx" ON "DomainEventOutbox" ("aggregateType", "aggregateId");
@@ -0,0 +1,11 @@
+-- 사업자등록번호 상태 조회 결과
+-- 번호별 최근 조회 결과를 보관하며, 매장 요청 상세 표시와 승인 가드에 사용됩니다.
+CREATE TABLE IF NOT EXISTS "BusinessLicenseVerification" (
+    "licenseNumber" TEXT PRIMARY KEY, -- 하이픈 없는 10자리
+    "status" TEXT NOT NULL,           -- ACTIVE, SUSPENDED, CLOSED, UNKNOWN, INVALID
+    "provider" TEXT NOT NULL,
+    "taxType" TEXT,
+    "closedAt" DATE,
+    "message" TEXT,
+    "checkedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
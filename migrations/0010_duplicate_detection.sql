@@ -0,0 +1,33 @@
+-- 중복 매장/사업자등록번호 탐지
+-- 매장 요청 검토 시 사업자등록번호, 전화번호, 주소의 정규화 값 일치와 매장명 유사도로 중복 후보를 찾습니다.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 숫자만 남김 (사업자등록번호)
+CREATE OR REPLACE FUNCTION normalize_digits(value TEXT) RETURNS TEXT AS $$
+    SELECT regexp_replace(COALESCE(value, ''), '[^0-9]', '', 'g')
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- 숫자만 남기고 국가번호(+82)를 국내 번호로 변환 (전화번호)
+CREATE OR REPLACE FUNCTION normalize_phone(value TEXT) RETURNS TEXT AS $$
+    SELECT regexp_replace(normalize_digits(value), '^82(?=[1-9])', '0')
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- 소문자로 바꾸고 공백과 문장 부호를 제거 (주소)
+CREATE OR REPLACE FUNCTION normalize_address(value TEXT) RETURNS TEXT AS $$
+    SELECT regexp_replace(lower(COALESCE(value, '')), '[[:space:][:punct:]]', '', 'g')
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE INDEX IF NOT EXISTS "RestaurantRequest_license_idx"
+    ON "RestaurantRequest" (normalize_digits("businessLicenseNumber"))
+    WHERE "deletedAt" IS NULL;
+CREATE INDEX IF NOT EXISTS "RestaurantBusiness_license_idx"
+    ON "RestaurantBusiness" (normalize_digits("licenseNumber"));
+CREATE INDEX IF NOT EXISTS "Restaurant_phone_idx"
+    ON "Restaurant" (normalize_phone("phoneNumber"))
+    WHERE "deletedAt" IS NULL;
+CREATE INDEX IF NOT EXISTS "Restaurant_address_idx"
+    ON "Restaurant" (normalize_address("address"))
+    WHERE "deletedAt" IS NULL;
+CREATE INDEX IF NOT EXISTS "Restaurant_name_trgm_idx"
+    ON "Restaurant" USING GIN (lower("name") gin_trgm_ops)
+    WHERE "deletedAt" IS NULL;
@@ -0,0 +1,27 @@
+-- 매장 정기 휴무일
+-- weekOfMonth가 NULL이면 매주, 1~5이면 해당 주차의 요일만 휴무입니다.
+CREATE TABLE IF NOT EXISTS "RestaurantHoliday" (
+    "id" SERIAL PRIMARY KEY,
+    "restaurantId" TEXT NOT NULL,
+    "dayOfWeek" TEXT NOT NULL, -- MON, TUE, WED, THU, FRI, SAT, SUN
+    "weekOfMonth" INTEGER      -- 1~5
+);
+
+CREATE INDEX IF NOT EXISTS "RestaurantHoliday_restaurantId_idx"
+    ON "RestaurantHoliday" ("restaurantId");
+
+-- 특정 날짜의 임시 휴무 또는 특별 영업 시간
+-- 예외 날짜는 정기 휴무일과 주간 영업 시간보다 우선합니다.
+CREATE TABLE IF NOT EXISTS "BusinessHourException" (
+    "id" SERIAL PRIMARY KEY,
+    "restaurantId" TEXT NOT NULL,
+    "date" DATE NOT NULL, -- Asia/Seoul 기준 날짜
+    "closed" BOOLEAN NOT NULL DEFAULT FALSE,
+    "openTime" TEXT,      -- HHmm 형식 (closed가 FALSE일 때)
+    "closeTime" TEXT,     -- HHmm 형식 (closed가 FALSE일 때)
+    "note" TEXT,
+    UNIQUE ("restaurantId", "date")
+);
+
+CREATE INDEX IF NOT EXISTS "BusinessHour_restaurantId_idx"
+    ON "BusinessHour" ("restaurantId");
@@ -0,0 +1,9 @@
+-- 메뉴 검수(숨김) 정보
+-- 숨긴 메뉴와 이미지는 삭제하지 않고 노출만 막으며, 마지막 검수 사유와 처리자를 남깁니다.
+ALTER TABLE "RestaurantMenu" ADD COLUMN IF NOT EXISTS "hidden" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "RestaurantMenu" ADD COLUMN IF NOT EXISTS "imageHidden" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "RestaurantMenu" ADD COLUMN IF NOT EXISTS "moderationReason" TEXT;
+ALTER TABLE "RestaurantMenu" ADD COLUMN IF NOT EXISTS "moderatedBy" TEXT;
+ALTER TABLE "RestaurantMenu" ADD COLUMN IF NOT EXISTS "moderatedAt" TIMESTAMP(3);
+
+CREATE INDEX IF NOT EXISTS "RestaurantMenu_restaurantId_idx" ON "RestaurantMenu" ("restaurantId");
@@ -0,0 +1,39 @@
+-- 태그 이름 정규화 (앞뒤 공백 제거, 연속 공백 하나로, 소문자)
+-- 대소문자와 공백만 다른 태그 이름은 같은 태그로 봅니다.
+CREATE OR REPLACE FUNCTION normalize_tag_name(value TEXT) RETURNS TEXT AS $$
+    SELECT lower(regexp_replace(btrim(coalesce(value, '')), '\s+', ' ', 'g'))
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- 기존 중복 태그 정리: 정규화한 이름이 같은 태그는 가장 작은 id의 태그로 합칩니다.
+-- 1) 합친 뒤 같은 매장에 같은 태그가 두 번 붙게 되는 연결은 먼저 삭제
+WITH canonical AS (
+    SELECT "id", min("id") OVER (PARTITION BY normalize_tag_name("name")) AS "keepId"
+    FROM "Tag"
+)
+DELETE FROM "RestaurantTag" a
+USING "RestaurantTag" b, canonical ca, canonical cb
+WHERE ca."id" = a."tagId" AND cb."id" = b."tagId"
+    AND a."restaurantId" = b."restaurantId"
+    AND ca."keepId" = cb."keepId"
+    AND a."id" > b."id";
+
+-- 2) 남은 연결을 남길 태그로 옮김
+WITH canonical AS (
+    SELECT "id", min("id") OVER (PARTITION BY normalize_tag_name("name")) AS "keepId"
+    FROM "Tag"
+)
+UPDATE "RestaurantTag" rt
+SET "tagId" = c."keepId"
+FROM canonical c
+WHERE rt."tagId" = c."id" AND c."id" <> c."keepId";
+
+-- 3) 중복 태그 삭제
+DELETE FROM "Tag" t
+WHERE EXISTS (
+    SELECT 1 FROM "Tag" o
+    WHERE normalize_tag_name(o."name") = normalize_tag_name(t."name") AND o."id" < t."id"
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "Tag_normalized_name_key" ON "Tag" (normalize_tag_name("name"));
+CREATE UNIQUE INDEX IF NOT EXISTS "RestaurantTag_restaurantId_tagId_key" ON "RestaurantTag" ("restaurantId", "tagId");
+CREATE INDEX IF NOT EXISTS "RestaurantTag_tagId_idx" ON "RestaurantTag" ("tagId");
@@ -0,0 +1,10 @@
+-- 관리자 주문 강제 취소 정보 (CS 처리용)
+ALTER TABLE "Order" ADD COLUMN IF NOT EXISTS "cancelReason" TEXT;
+ALTER TABLE "Order" ADD COLUMN IF NOT EXISTS "cancelledBy" TEXT;
+ALTER TABLE "Order" ADD COLUMN IF NOT EXISTS "cancelledAt" TIMESTAMP(3);
+
+-- 관리자 주문 검색용 인덱스
+CREATE INDEX IF NOT EXISTS "Order_restaurantId_createdAt_idx" ON "Order" ("restaurantId", "createdAt");
+CREATE INDEX IF NOT EXISTS "Order_customerId_createdAt_idx" ON "Order" ("customerId", "createdAt");
+CREATE INDEX IF NOT EXISTS "Order_status_createdAt_idx" ON "Order" ("status", "createdAt");
+CREATE INDEX IF NOT EXISTS "OrderMenu_orderId_idx" ON "OrderMenu" ("orderId");
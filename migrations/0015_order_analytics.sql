@@ -0,0 +1,2 @@
+-- 관리자 매출/주문 분석용 인덱스 (기간 조건만 있는 전체 집계)
+CREATE INDEX IF NOT EXISTS "Order_createdAt_idx" ON "Order" ("createdAt");
@@ -0,0 +1,9 @@
+-- 관리자 사용자 계정 정지 정보
+-- 역할은 기존 "role" 컬럼을 사용하며, 정지된 계정은 관리자 세션 검증에서 거부됩니다.
+ALTER TABLE "User" ADD COLUMN IF NOT EXISTS "suspendedAt" TIMESTAMP(3);
+ALTER TABLE "User" ADD COLUMN IF NOT EXISTS "suspendedBy" TEXT;
+ALTER TABLE "User" ADD COLUMN IF NOT EXISTS "suspendReason" TEXT;
+
+-- 관리자 사용자 검색용 인덱스
+CREATE INDEX IF NOT EXISTS "User_role_idx" ON "User" ("role");
+CREATE INDEX IF NOT EXISTS "AdminSession_userId_idx" ON "AdminSession" ("userId");
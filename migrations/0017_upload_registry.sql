@@ -0,0 +1,25 @@
+-- presigned 업로드 등록부
+-- 업로드 URL 발급 시 PENDING으로 기록하고, 확인 API가 객체를 HEAD해 크기와 ETag를 기록하면 CONFIRMED가 됩니다.
+-- 정리 작업은 TTL이 지나도록 확인되지 않았거나 매장 이미지, 메뉴 이미지, 사업자등록증 이미지 어디에서도 참조하지 않는 객체를 삭제하고 DELETED로 남깁니다.
+CREATE TABLE IF NOT EXISTS "Upload" (
+    "id" TEXT PRIMARY KEY,
+    "userId" TEXT NOT NULL,
+    "purpose" TEXT NOT NULL,
+    "bucket" TEXT NOT NULL,
+    "key" TEXT NOT NULL,
+    "contentType" TEXT NOT NULL,
+    "maxFileSize" BIGINT NOT NULL,
+    "status" TEXT NOT NULL DEFAULT 'PENDING',
+    "size" BIGINT,
+    "etag" TEXT,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "confirmedAt" TIMESTAMP(3),
+    "deletedAt" TIMESTAMP(3),
+    "deleteReason" TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "Upload_bucket_key_key" ON "Upload" ("bucket", "key");
+CREATE INDEX IF NOT EXISTS "Upload_cleanup_idx"
+    ON "Upload" ("createdAt")
+    WHERE "status" <> 'DELETED';
+CREATE INDEX IF NOT EXISTS "Upload_userId_idx" ON "Upload" ("userId");
@@ -0,0 +1,21 @@
+package config
+
+import "time"
+
+// AnalyticsConfig는 관리자 매출/주문 분석 설정을 위한 구조체입니다.
+type AnalyticsConfig struct {
+	Cache           string        // 집계 결과 캐시 (memory, none)
+	CacheTTL        time.Duration // 집계 결과 재사용 기간
+	CacheMaxEntries int           // memory 캐시에 보관할 최대 항목 수
+	DefaultDays     int           // 기간을 지정하지 않았을 때 조회할 최근 일수
+}
+
+// NewAnalyticsConfig는 환경 변수에서 분석 설정을 로드합니다.
+func (c *Config) NewAnalyticsConfig() *AnalyticsConfig {
+	return &AnalyticsConfig{
+		Cache:           GetEnvOrDefault("ANALYTICS_CACHE", "memory"),
+		CacheTTL:        GetEnvDurationMinutes("ANALYTICS_CACHE_TTL_MINUTES", 5),
+		CacheMaxEntries: getEnvInt("ANALYTICS_CACHE_MAX_ENTRIES", 500),
+		DefaultDays:     getEnvInt("ANALYTICS_DEFAULT_DAYS", 30),
+	}
+}
@@ -4,13 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
-// NewS3Client는 AWS S3 클라이언트와 Presign 클라이언트를 생성합니다.
-func NewS3Client(ctx context.Context, cfg *Config) (*s3.Client, *s3.PresignClient, error) {
+// LoadAWSConfig는 리전과 자격 증명 설정을 반영한 AWS 설정을 로드합니다.
+func LoadAWSConfig(ctx context.Context, cfg *Config) (aws.Config, error) {
 	// 기본 옵션 슬라이스 생성
 	options := []func(*config.LoadOptions) error{
 		config.WithRegion(cfg.AWSRegion),
@@ -28,11 +29,20 @@ func NewS3Client(ctx context.Context, cfg *Config) (*s3.Client, *s3.PresignClien
 	// AWS 설정 로드
 	awsCfg, err := config.LoadDefaultConfig(ctx, options...)
 	if err != nil {
-		return nil, nil, fmt.Errorf("AWS 설정 로드 실패: %w", err)
+		return aws.Config{}, fmt.Errorf("AWS 설정 로드 실패: %w", err)
+	}
+	return awsCfg, nil
+}
+
+// NewS3Client는 AWS S3 클라이언트와 Presign 클라이언트를 생성합니다.
+func NewS3Client(ctx context.Context, cfg *Config) (*s3.Client, *s3.PresignClient, error) {
+	awsCfg, err := LoadAWSConfig(ctx, cfg)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	s3Client := s3.NewFromConfig(awsCfg)
 	presignClient := s3.NewPresignClient(s3Client)
 	
 	return s3Client, presignClient, nil
-}
\ No newline at end of file
+}
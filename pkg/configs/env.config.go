@@ -3,6 +3,8 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Config는 애플리케이션 설정 값을 관리하는 구조체입니다.
@@ -14,6 +16,13 @@ type Config struct {
 	DefaultMaxFileSize int64
 	Environment        string
 	JWTSecret          string
+	ClaimTTL           time.Duration // 매장 요청 점유 기본 유지 시간
+	MaxClaimTTL        time.Duration // 매장 요청 점유 최대 유지 시간
+	SuperAdminIDs      []string      // 점유 무시 등 상위 권한을 가진 관리자 ID 목록
+	RequestSLA         time.Duration // 매장 요청 처리 목표 시간
+	HandlerMode        string        // 실행 모드 (api: API Gateway 요청 처리, worker: 알림 발송 워커, relay: 이벤트 릴레이, verifier: 업로드 검증, cleanup: 업로드 정리)
+	NotifyChannels     []string      // 점주 알림 발송 채널 (EMAIL, SMS, WEB_PUSH, LOG)
+	NotifyLocale       string        // 점주 알림 기본 언어 (ko, en)
 }
 
 // 환경 변수로부터 기본값을 가져오는 함수
@@ -25,6 +34,36 @@ func GetEnvOrDefault(key, defaultValue string) string {
 	return value
 }
 
+// 환경 변수로부터 분 단위 기간을 가져오는 함수
+func GetEnvDurationMinutes(key string, defaultMinutes int) time.Duration {
+	minutes, err := strconv.Atoi(GetEnvOrDefault(key, ""))
+	if err != nil || minutes <= 0 {
+		minutes = defaultMinutes
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// 환경 변수로부터 콤마로 구분된 목록을 가져오는 함수
+func GetEnvList(key string) []string {
+	values := []string{}
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// IsSuperAdmin은 사용자가 슈퍼 관리자인지 확인합니다.
+func (c *Config) IsSuperAdmin(userID string) bool {
+	for _, id := range c.SuperAdminIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
 // NewConfig는 환경 변수에서 설정을 로드하여 Config 구조체를 반환합니다.
 func NewConfig() *Config {
 	defaultMaxFileSizeStr := GetEnvOrDefault("DEFAULT_MAX_FILE_SIZE", "10485760")
@@ -33,6 +72,11 @@ func NewConfig() *Config {
 		defaultMaxFileSize = 10485760 // 10MB 기본값
 	}
 
+	notifyChannels := GetEnvList("NOTIFICATION_CHANNELS")
+	if len(notifyChannels) == 0 {
+		notifyChannels = []string{"LOG"}
+	}
+
 	return &Config{
 		AWSRegion:          GetEnvOrDefault("AWS_REGION", "ap-northeast-2"),
 		AWSAccessKeyID:     GetEnvOrDefault("AWS_ACCESS_KEY_ID", ""),
@@ -41,6 +85,13 @@ func NewConfig() *Config {
 		DefaultMaxFileSize: defaultMaxFileSize,
 		Environment:        GetEnvOrDefault("ENV", "dev"),
 		JWTSecret:          GetEnvOrDefault("JWT_SECRET", "1234567890abcdef"),
+		ClaimTTL:           GetEnvDurationMinutes("CLAIM_TTL_MINUTES", 30),
+		MaxClaimTTL:        GetEnvDurationMinutes("MAX_CLAIM_TTL_MINUTES", 120),
+		SuperAdminIDs:      GetEnvList("SUPER_ADMIN_IDS"),
+		RequestSLA:         GetEnvDurationMinutes("REQUEST_SLA_MINUTES", 48*60),
+		HandlerMode:        GetEnvOrDefault("HANDLER_MODE", "api"),
+		NotifyChannels:     notifyChannels,
+		NotifyLocale:       GetEnvOrDefault("NOTIFICATION_LOCALE", "ko"),
 	}
 }
 
@@ -0,0 +1,31 @@
+package config
+
+import "time"
+
+// EventConfig는 도메인 이벤트 릴레이와 싱크 설정을 위한 구조체입니다.
+type EventConfig struct {
+	Sink         string        // 이벤트 싱크 (sns, sqs, eventbridge, file, memory)
+	SNSTopicARN  string        // sns 싱크 토픽 ARN (.fifo 토픽이면 중복 제거 ID 사용)
+	SQSQueueURL  string        // sqs 싱크 큐 URL (.fifo 큐면 중복 제거 ID 사용)
+	EventBusName string        // eventbridge 싱크 이벤트 버스 이름
+	EventSource  string        // eventbridge 이벤트 source 값
+	FilePath     string        // file 싱크 출력 경로 (JSON Lines)
+	MaxAttempts  int           // 최대 발행 시도 횟수
+	BatchSize    int           // 릴레이 1회 실행 시 처리할 최대 이벤트 수
+	Lease        time.Duration // 릴레이가 가져간 이벤트를 다른 릴레이가 가져가지 못하는 시간
+}
+
+// NewEventConfig는 환경 변수에서 이벤트 설정을 로드합니다.
+func (c *Config) NewEventConfig() *EventConfig {
+	return &EventConfig{
+		Sink:         GetEnvOrDefault("EVENT_SINK", ""),
+		SNSTopicARN:  GetEnvOrDefault("EVENT_SNS_TOPIC_ARN", ""),
+		SQSQueueURL:  GetEnvOrDefault("EVENT_SQS_QUEUE_URL", ""),
+		EventBusName: GetEnvOrDefault("EVENT_BUS_NAME", "default"),
+		EventSource:  GetEnvOrDefault("EVENT_SOURCE", "lambda-go.admin"),
+		FilePath:     GetEnvOrDefault("EVENT_FILE_PATH", "/tmp/domain-events.jsonl"),
+		MaxAttempts:  getEnvInt("EVENT_MAX_ATTEMPTS", 10),
+		BatchSize:    getEnvInt("EVENT_BATCH_SIZE", 100),
+		Lease:        GetEnvDurationMinutes("EVENT_LEASE_MINUTES", 5),
+	}
+}
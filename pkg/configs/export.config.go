@@ -0,0 +1,23 @@
+package config
+
+import "time"
+
+// ExportConfig는 관리자 목록 내보내기 설정을 위한 구조체입니다.
+type ExportConfig struct {
+	Bucket        string        // 내보내기 파일을 저장할 비공개 S3 버킷 (업로드 버킷과 분리, 수명 주기 만료 규칙 필요)
+	Prefix        string        // 내보내기 파일 키 접두사
+	InlineMaxRows int           // S3를 거치지 않고 바로 응답할 CSV 최대 행 수
+	MaxRows       int           // 한 번에 내보낼 수 있는 최대 행 수
+	URLExpiry     time.Duration // 다운로드 URL 유효 기간
+}
+
+// NewExportConfig는 환경 변수에서 내보내기 설정을 로드합니다.
+func (c *Config) NewExportConfig() *ExportConfig {
+	return &ExportConfig{
+		Bucket:        GetEnvOrDefault("EXPORT_BUCKET", ""),
+		Prefix:        GetEnvOrDefault("EXPORT_PREFIX", "exports/"),
+		InlineMaxRows: getEnvInt("EXPORT_INLINE_MAX_ROWS", 1000),
+		MaxRows:       getEnvInt("EXPORT_MAX_ROWS", 50000),
+		URLExpiry:     GetEnvDurationMinutes("EXPORT_URL_TTL_MINUTES", 15),
+	}
+}
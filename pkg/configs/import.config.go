@@ -0,0 +1,23 @@
+package config
+
+import "time"
+
+// ImportConfig는 관리자 매장 일괄 등록(CSV 가져오기) 설정을 위한 구조체입니다.
+type ImportConfig struct {
+	Bucket      string        // 가져올 CSV 파일을 업로드할 S3 버킷
+	Prefix      string        // 업로드 키 접두사. 가져오기는 이 접두사 아래의 파일만 읽습니다
+	MaxFileSize int64         // 업로드할 수 있는 최대 CSV 크기 (바이트)
+	MaxRows     int           // 한 번에 가져올 수 있는 최대 행 수
+	URLExpiry   time.Duration // 업로드/오류 리포트 URL 유효 기간
+}
+
+// NewImportConfig는 환경 변수에서 가져오기 설정을 로드합니다.
+func (c *Config) NewImportConfig() *ImportConfig {
+	return &ImportConfig{
+		Bucket:      GetEnvOrDefault("IMPORT_BUCKET", c.DefaultBucket),
+		Prefix:      GetEnvOrDefault("IMPORT_PREFIX", "imports/"),
+		MaxFileSize: int64(getEnvInt("IMPORT_MAX_FILE_SIZE", 5*1024*1024)),
+		MaxRows:     getEnvInt("IMPORT_MAX_ROWS", 1000),
+		URLExpiry:   GetEnvDurationMinutes("IMPORT_URL_TTL_MINUTES", 15),
+	}
+}
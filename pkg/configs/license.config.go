@@ -0,0 +1,25 @@
+package config
+
+import "time"
+
+// LicenseConfig는 사업자등록번호 상태 조회 설정을 위한 구조체입니다.
+type LicenseConfig struct {
+	Verifier      string        // 조회 제공자 (nts, fake)
+	NTSEndpoint   string        // 국세청 사업자등록 상태조회 API 주소
+	NTSServiceKey string        // 공공데이터포털 서비스 키
+	FakeStatuses  []string      // fake 제공자의 번호별 상태 ("번호:상태" 목록, 나머지는 ACTIVE)
+	HTTPTimeout   time.Duration // 조회 요청 제한 시간
+	TTL           time.Duration // 조회 결과 재사용 기간
+}
+
+// NewLicenseConfig는 환경 변수에서 사업자등록번호 조회 설정을 로드합니다.
+func (c *Config) NewLicenseConfig() *LicenseConfig {
+	return &LicenseConfig{
+		Verifier:      GetEnvOrDefault("LICENSE_VERIFIER", "fake"),
+		NTSEndpoint:   GetEnvOrDefault("NTS_API_ENDPOINT", "https://api.odcloud.kr/api/nts-businessman/v1/status"),
+		NTSServiceKey: GetEnvOrDefault("NTS_SERVICE_KEY", ""),
+		FakeStatuses:  GetEnvList("LICENSE_FAKE_STATUSES"),
+		HTTPTimeout:   10 * time.Second,
+		TTL:           time.Duration(getEnvInt("LICENSE_VERIFY_TTL_HOURS", 24)) * time.Hour,
+	}
+}
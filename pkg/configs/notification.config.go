@@ -0,0 +1,53 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+// NotificationConfig는 알림 채널과 발송 워커 설정을 위한 구조체입니다.
+type NotificationConfig struct {
+	SMTPHost          string
+	SMTPPort          string
+	SMTPUsername      string // 비어 있으면 인증 없이 발송 (MailHog 등 로컬 SMTP)
+	SMTPPassword      string
+	SMTPFrom          string
+	SMSGatewayURL     string
+	SMSGatewayAPIKey  string
+	SMSSender         string
+	PushGatewayURL    string
+	PushGatewayAPIKey string
+	HTTPTimeout       time.Duration // SMS/웹 푸시 게이트웨이 요청 제한 시간
+	MaxAttempts       int           // 최대 발송 시도 횟수
+	BatchSize         int           // 워커 1회 실행 시 처리할 최대 알림 수
+	Lease             time.Duration // 워커가 가져간 알림을 다른 워커가 가져가지 못하는 시간
+}
+
+// NewNotificationConfig는 환경 변수에서 알림 설정을 로드합니다.
+func (c *Config) NewNotificationConfig() *NotificationConfig {
+	return &NotificationConfig{
+		SMTPHost:          GetEnvOrDefault("SMTP_HOST", "localhost"),
+		SMTPPort:          GetEnvOrDefault("SMTP_PORT", "1025"),
+		SMTPUsername:      GetEnvOrDefault("SMTP_USERNAME", ""),
+		SMTPPassword:      GetEnvOrDefault("SMTP_PASSWORD", ""),
+		SMTPFrom:          GetEnvOrDefault("SMTP_FROM", "no-reply@example.com"),
+		SMSGatewayURL:     GetEnvOrDefault("SMS_GATEWAY_URL", ""),
+		SMSGatewayAPIKey:  GetEnvOrDefault("SMS_GATEWAY_API_KEY", ""),
+		SMSSender:         GetEnvOrDefault("SMS_SENDER", ""),
+		PushGatewayURL:    GetEnvOrDefault("PUSH_GATEWAY_URL", ""),
+		PushGatewayAPIKey: GetEnvOrDefault("PUSH_GATEWAY_API_KEY", ""),
+		HTTPTimeout:       10 * time.Second,
+		MaxAttempts:       getEnvInt("NOTIFICATION_MAX_ATTEMPTS", 5),
+		BatchSize:         getEnvInt("NOTIFICATION_BATCH_SIZE", 50),
+		Lease:             GetEnvDurationMinutes("NOTIFICATION_LEASE_MINUTES", 5),
+	}
+}
+
+// 환경 변수로부터 양의 정수를 가져오는 함수
+func getEnvInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(GetEnvOrDefault(key, ""))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,40 @@
+package config
+
+import "time"
+
+// UploadConfig는 클라이언트용 presigned URL 발급 정책 설정을 위한 구조체입니다.
+type UploadConfig struct {
+	AllowedBuckets   []string      // presigned URL을 발급할 수 있는 버킷 (기본값: DEFAULT_BUCKET)
+	MaxURLExpiry     time.Duration // presigned URL 최대 유효 기간
+	QuarantinePrefix string        // 업로드 검증에 실패한 객체를 옮길 키 접두사
+	PendingTTL       time.Duration // 업로드 URL 발급 후 확인하지 않은 객체를 정리하기까지의 시간
+	UnreferencedTTL  time.Duration // 확인 후 어디에서도 참조하지 않는 객체를 정리하기까지의 시간
+	CleanupBatchSize int           // 정리 작업 1회 실행 시 처리할 최대 업로드 수
+}
+
+// NewUploadConfig는 환경 변수에서 업로드 정책 설정을 로드합니다.
+func (c *Config) NewUploadConfig() *UploadConfig {
+	buckets := GetEnvList("S3_ALLOWED_BUCKETS")
+	if len(buckets) == 0 && c.DefaultBucket != "" {
+		buckets = []string{c.DefaultBucket}
+	}
+
+	return &UploadConfig{
+		AllowedBuckets:   buckets,
+		MaxURLExpiry:     GetEnvDurationMinutes("S3_MAX_URL_TTL_MINUTES", 60),
+		QuarantinePrefix: GetEnvOrDefault("S3_QUARANTINE_PREFIX", "quarantine/"),
+		PendingTTL:       GetEnvDurationMinutes("UPLOAD_PENDING_TTL_MINUTES", 24*60),
+		UnreferencedTTL:  GetEnvDurationMinutes("UPLOAD_UNREFERENCED_TTL_MINUTES", 7*24*60),
+		CleanupBatchSize: getEnvInt("UPLOAD_CLEANUP_BATCH_SIZE", 100),
+	}
+}
+
+// IsAllowedBucket은 presigned URL을 발급할 수 있는 버킷인지 확인합니다.
+func (c *UploadConfig) IsAllowedBucket(bucket string) bool {
+	for _, allowed := range c.AllowedBuckets {
+		if allowed == bucket {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package context
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// GetHeader는 요청 헤더 값을 대소문자 구분 없이 가져옵니다
+func GetHeader(request events.APIGatewayProxyRequest, name string) string {
+	if value, ok := request.Headers[name]; ok {
+		return value
+	}
+	for key, value := range request.Headers {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
+
+// GetIfMatchVersion은 If-Match 헤더의 ETag에서 버전을 추출합니다
+// 헤더가 없거나 "*"이면 nil을 반환합니다
+func GetIfMatchVersion(request events.APIGatewayProxyRequest) (*int, error) {
+	ifMatch := strings.TrimSpace(GetHeader(request, "If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+
+	tag := strings.TrimPrefix(ifMatch, "W/")
+	tag = strings.Trim(tag, `"`)
+
+	version, err := strconv.Atoi(tag)
+	if err != nil {
+		return nil, ErrInvalidParam
+	}
+	return &version, nil
+}
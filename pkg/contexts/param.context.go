@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 )
@@ -59,6 +60,27 @@ func GetBoolParam(request events.APIGatewayProxyRequest, paramName string, defau
 	return defaultValue
 }
 
+// GetTimeParam은 쿼리 파라미터에서 RFC3339 또는 YYYY-MM-DD 형식의 시간 값을 추출합니다
+// 파라미터가 없으면 nil을 반환합니다
+func GetTimeParam(request events.APIGatewayProxyRequest, paramName string) (*time.Time, error) {
+	return GetTimeParamIn(request, paramName, time.UTC)
+}
+
+// GetTimeParamIn은 GetTimeParam과 같지만 YYYY-MM-DD 형식을 지정한 시간대의 자정으로 해석합니다
+func GetTimeParamIn(request events.APIGatewayProxyRequest, paramName string, loc *time.Location) (*time.Time, error) {
+	paramStr := request.QueryStringParameters[paramName]
+	if paramStr == "" {
+		return nil, nil
+	}
+	if value, err := time.Parse(time.RFC3339, paramStr); err == nil {
+		return &value, nil
+	}
+	if value, err := time.ParseInLocation("2006-01-02", paramStr, loc); err == nil {
+		return &value, nil
+	}
+	return nil, ErrInvalidParam
+}
+
 // ParsePaginationParams는 페이지네이션 관련 파라미터를 파싱합니다
 func ParsePaginationParams(request events.APIGatewayProxyRequest) (page, pageSize int) {
 	page = GetIntParam(request, "page", 1)
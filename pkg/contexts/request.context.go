@@ -0,0 +1,56 @@
+package context
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+const RequestMetaKey contextKey = "requestMeta"
+
+// RequestMeta는 감사 로그 등에 사용하는 요청 메타데이터입니다
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+// NewRequestMeta는 API Gateway 요청에서 메타데이터를 추출합니다
+func NewRequestMeta(request events.APIGatewayProxyRequest) RequestMeta {
+	ip, _ := GetClientIP(request)
+	return RequestMeta{
+		IP:        ip,
+		UserAgent: GetHeader(request, "User-Agent"),
+		RequestID: request.RequestContext.RequestID,
+	}
+}
+
+// WithRequestMeta는 컨텍스트에 요청 메타데이터를 저장합니다
+func WithRequestMeta(ctx context.Context, meta RequestMeta) context.Context {
+	return context.WithValue(ctx, RequestMetaKey, meta)
+}
+
+// GetRequestMeta는 컨텍스트에서 요청 메타데이터를 가져옵니다
+func GetRequestMeta(ctx context.Context) RequestMeta {
+	meta, _ := ctx.Value(RequestMetaKey).(RequestMeta)
+	return meta
+}
+
+// GetClientIP는 요청에서 클라이언트 IP를 추출합니다
+func GetClientIP(request events.APIGatewayProxyRequest) (string, error) {
+	// API Gateway 프록시 요청에서 클라이언트 IP 추출
+	if ip := GetHeader(request, "X-Forwarded-For"); ip != "" {
+		// X-Forwarded-For는 콤마로 구분된 IP 목록일 수 있으므로 첫 번째 IP 사용
+		ips := strings.Split(ip, ",")
+		return strings.TrimSpace(ips[0]), nil
+	}
+
+	// 헤더에 없다면 요청 컨텍스트에서 추출 시도
+	if request.RequestContext.Identity.SourceIP != "" {
+		return request.RequestContext.Identity.SourceIP, nil
+	}
+
+	return "", errors.New("클라이언트 IP 추출 실패")
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// parseAnalyticsQuery는 분석 조회 공통 쿼리 파라미터를 파싱합니다.
+// 날짜만 지정한 from/to는 Asia/Seoul 자정으로 해석합니다.
+func parseAnalyticsQuery(request events.APIGatewayProxyRequest) (dto.AnalyticsQuery, error) {
+	query := dto.AnalyticsQuery{
+		Interval:  models.AnalyticsInterval(appCtx.GetStringParam(request, "interval", string(models.INTERVAL_DAY))),
+		Sort:      dto.AnalyticsSort(appCtx.GetStringParam(request, "sort", string(dto.ANALYTICS_SORT_GMV))),
+		Limit:     appCtx.GetIntParam(request, "limit", 10),
+		MinOrders: appCtx.GetIntParam(request, "minOrders", 10),
+	}
+
+	from, err := appCtx.GetTimeParamIn(request, "from", models.SeoulLocation)
+	if err != nil {
+		return query, utils.BadRequest("from 파라미터 형식이 잘못되었습니다")
+	}
+	if from != nil {
+		query.From = *from
+	}
+	to, err := appCtx.GetTimeParamIn(request, "to", models.SeoulLocation)
+	if err != nil {
+		return query, utils.BadRequest("to 파라미터 형식이 잘못되었습니다")
+	}
+	if to != nil {
+		query.To = *to
+	}
+
+	switch query.Interval {
+	case models.INTERVAL_DAY, models.INTERVAL_WEEK, models.INTERVAL_MONTH:
+	default:
+		return query, utils.BadRequest("interval은 day, week, month 중 하나여야 합니다")
+	}
+
+	switch query.Sort {
+	case dto.ANALYTICS_SORT_GMV, dto.ANALYTICS_SORT_ORDERS:
+	default:
+		return query, utils.BadRequest("sort는 gmv, orders 중 하나여야 합니다")
+	}
+
+	if query.Limit < 1 || query.Limit > 100 {
+		query.Limit = 10 // 기본값으로 제한
+	}
+	if query.MinOrders < 1 {
+		query.MinOrders = 1
+	}
+
+	if restaurantID := appCtx.GetStringParam(request, "restaurantId", ""); restaurantID != "" {
+		query.RestaurantID = &restaurantID
+	}
+
+	return query, nil
+}
+
+// GetSalesAnalytics는 일/주/월 단위 주문 수와 GMV를 조회합니다.
+func (h *AdminHandler) GetSalesAnalytics(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query, err := parseAnalyticsQuery(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	resp, err := h.AnalyticsService.GetSales(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetTopRestaurants는 매출 상위 매장을 조회합니다.
+func (h *AdminHandler) GetTopRestaurants(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query, err := parseAnalyticsQuery(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	resp, err := h.AnalyticsService.GetTopRestaurants(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetTopMenus는 매출 상위 메뉴를 조회합니다.
+func (h *AdminHandler) GetTopMenus(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query, err := parseAnalyticsQuery(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	resp, err := h.AnalyticsService.GetTopMenus(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetCancellationRates는 매장별 주문 취소/거절 비율을 조회합니다.
+func (h *AdminHandler) GetCancellationRates(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query, err := parseAnalyticsQuery(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	resp, err := h.AnalyticsService.GetCancellationRates(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetDeliveryMix는 배달 유형별 주문 비중을 조회합니다.
+func (h *AdminHandler) GetDeliveryMix(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query, err := parseAnalyticsQuery(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	resp, err := h.AnalyticsService.GetDeliveryMix(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+
+	dto "lambda-go/pkg/models/dtos"
+	adminService "lambda-go/pkg/services/admin"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// GetAuditLogs는 감사 로그를 필터와 커서로 조회합니다.
+func (h *AdminHandler) GetAuditLogs(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query := dto.AuditLogQuery{
+		ActorID:    appCtx.GetStringParam(request, "actorId", ""),
+		Action:     models.AuditAction(appCtx.GetStringParam(request, "action", "")),
+		TargetType: models.AuditTargetType(appCtx.GetStringParam(request, "targetType", "")),
+		TargetID:   appCtx.GetStringParam(request, "targetId", ""),
+		Limit:      appCtx.GetIntParam(request, "limit", 50),
+	}
+	if query.Limit < 1 || query.Limit > 200 {
+		query.Limit = 50
+	}
+
+	var err error
+	if query.From, err = appCtx.GetTimeParam(request, "from"); err != nil {
+		return h.HandleAppError(utils.BadRequest("from 파라미터 형식이 잘못되었습니다")), nil
+	}
+	if query.To, err = appCtx.GetTimeParam(request, "to"); err != nil {
+		return h.HandleAppError(utils.BadRequest("to 파라미터 형식이 잘못되었습니다")), nil
+	}
+
+	if cursor := appCtx.GetStringParam(request, "cursor", ""); cursor != "" {
+		id, err := adminService.DecodeAuditCursor(cursor)
+		if err != nil {
+			return h.HandleAppError(utils.BadRequest("유효하지 않은 커서입니다")), nil
+		}
+		query.Cursor = &id
+	}
+
+	resp, err := h.AuditService.GetAuditLogs(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// GetInFlightDeliveries는 배달 중인 주문 배달 목록을 조회합니다.
+func (h *AdminHandler) GetInFlightDeliveries(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query := dto.DeliveryQuery{
+		Overdue: appCtx.GetBoolParam(request, "overdue", false),
+	}
+
+	query.Page, query.PageSize = appCtx.ParsePaginationParams(request)
+
+	if restaurantID := appCtx.GetStringParam(request, "restaurantId", ""); restaurantID != "" {
+		query.RestaurantID = &restaurantID
+	}
+
+	typeStr := appCtx.GetStringParam(request, "type", "")
+	if typeStr != "" {
+		deliveryType := models.DeliveryType(typeStr)
+		if deliveryType == models.SELF || deliveryType == models.DELIVERY {
+			query.Type = &deliveryType
+		}
+	}
+
+	resp, err := h.OrderService.GetInFlightDeliveries(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// CorrectDeliveryStatus는 주문 배달 상태를 정정합니다.
+func (h *AdminHandler) CorrectDeliveryStatus(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	orderID := appCtx.GetParam(ctx, "id")
+	if orderID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 주문 ID입니다")), nil
+	}
+
+	var payload models.CorrectDeliveryStatusRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.OrderService.CorrectDeliveryStatus(ctx, h.Actor(ctx), orderID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+	"net/url"
+	"strings"
+
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// parseExportOptions는 format, columns, download 파라미터를 파싱합니다.
+func parseExportOptions(request events.APIGatewayProxyRequest) (dto.ExportOptions, error) {
+	options := dto.ExportOptions{
+		Format:   models.ExportFormat(appCtx.GetStringParam(request, "format", string(models.EXPORT_CSV))),
+		Download: appCtx.GetBoolParam(request, "download", false),
+	}
+
+	if options.Format != models.EXPORT_CSV && options.Format != models.EXPORT_XLSX {
+		return options, utils.BadRequest("format은 csv 또는 xlsx여야 합니다")
+	}
+
+	for _, key := range strings.Split(appCtx.GetStringParam(request, "columns", ""), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			options.Columns = append(options.Columns, key)
+		}
+	}
+
+	return options, nil
+}
+
+// exportResponse는 내보내기 결과를 응답으로 변환합니다.
+// 파일을 바로 응답할 때는 Content-Disposition으로 파일명을 전달하고, 그 외에는 다운로드 URL을 JSON으로 응답합니다.
+func (h *AdminHandler) exportResponse(result *models.ExportResult) events.APIGatewayProxyResponse {
+	if result.File == nil {
+		return h.SuccessResponse(http.StatusOK, result.Download)
+	}
+
+	response := h.CorsResponse(events.APIGatewayProxyResponse{
+		StatusCode: http.StatusOK,
+		Headers: map[string]string{
+			"Content-Type":        result.File.ContentType,
+			"Content-Disposition": "attachment; filename*=UTF-8''" + url.PathEscape(result.File.FileName),
+		},
+		Body: string(result.File.Body),
+	})
+	response.Headers["Access-Control-Expose-Headers"] = "ETag,Content-Disposition"
+	return response
+}
+
+// ExportRestaurantRequests는 매장 요청 목록을 목록 조회와 같은 필터로 내보냅니다.
+func (h *AdminHandler) ExportRestaurantRequests(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	options, err := parseExportOptions(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	result, err := h.ExportService.ExportRestaurantRequests(ctx, h.parseRestaurantRequestQuery(ctx, request), options)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.exportResponse(result), nil
+}
+
+// ExportRestaurants는 매장 목록을 목록 조회와 같은 필터로 내보냅니다.
+func (h *AdminHandler) ExportRestaurants(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	options, err := parseExportOptions(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	result, err := h.ExportService.ExportRestaurants(ctx, parseRestaurantQuery(request), options)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.exportResponse(result), nil
+}
+
+// ExportOrders는 주문 목록을 목록 조회와 같은 필터로 내보냅니다.
+func (h *AdminHandler) ExportOrders(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	options, err := parseExportOptions(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	query, err := parseOrderQuery(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	result, err := h.ExportService.ExportOrders(ctx, query, options)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.exportResponse(result), nil
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// CreateRestaurantImportUploadURL은 매장 일괄 등록 CSV 업로드 URL을 발급합니다.
+func (h *AdminHandler) CreateRestaurantImportUploadURL(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	result, err := h.ImportService.CreateUploadURL(ctx)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// ImportRestaurants는 업로드한 CSV로 매장을 일괄 등록하거나(dryRun이면) 검증만 합니다.
+func (h *AdminHandler) ImportRestaurants(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var payload models.ImportRestaurantsRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.ImportService.ImportRestaurants(ctx, h.Actor(ctx), &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
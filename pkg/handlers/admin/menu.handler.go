@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// GetRestaurantMenus는 매장의 메뉴 목록을 조회합니다.
+// hidden=true면 숨긴 메뉴만, hidden=false면 노출 중인 메뉴만 조회합니다.
+func (h *AdminHandler) GetRestaurantMenus(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	var hidden *bool
+	switch appCtx.GetStringParam(request, "hidden", "") {
+	case "true":
+		value := true
+		hidden = &value
+	case "false":
+		value := false
+		hidden = &value
+	}
+
+	result, err := h.AdminService.GetRestaurantMenus(ctx, restaurantID, hidden)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// ModerateRestaurantMenu는 메뉴 정보를 수정하거나 메뉴/이미지를 숨깁니다.
+func (h *AdminHandler) ModerateRestaurantMenu(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	menuID := appCtx.GetParam(ctx, "menuId")
+	if menuID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 메뉴 ID입니다")), nil
+	}
+
+	var payload models.ModerateRestaurantMenuRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.ModerateRestaurantMenu(ctx, h.Actor(ctx), restaurantID, menuID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// HideRestaurantMenus는 매장의 모든 메뉴를 숨깁니다.
+func (h *AdminHandler) HideRestaurantMenus(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	var payload models.HideRestaurantMenusRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.HideRestaurantMenus(ctx, h.Actor(ctx), restaurantID, payload.Reason)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// parseOrderQuery는 주문 목록 필터를 파싱합니다. 목록 조회와 내보내기에서 함께 사용합니다.
+func parseOrderQuery(request events.APIGatewayProxyRequest) (dto.OrderQuery, error) {
+	query := dto.OrderQuery{}
+
+	query.Page, query.PageSize = appCtx.ParsePaginationParams(request)
+
+	if restaurantID := appCtx.GetStringParam(request, "restaurantId", ""); restaurantID != "" {
+		query.RestaurantID = &restaurantID
+	}
+
+	if customerID := appCtx.GetStringParam(request, "customerId", ""); customerID != "" {
+		query.CustomerID = &customerID
+	}
+
+	statusStr := appCtx.GetStringParam(request, "status", "")
+	if statusStr != "" {
+		status := models.OrderStatus(statusStr)
+		switch status {
+		case models.ORDER_NEW, models.ORDER_PROCESSING, models.ORDER_COMPLETED, models.ORDER_REJECTED, models.ORDER_CANCELLED:
+			query.Status = &status
+		}
+	}
+
+	var err error
+	if query.From, err = appCtx.GetTimeParam(request, "from"); err != nil {
+		return query, utils.BadRequest("from 파라미터 형식이 잘못되었습니다")
+	}
+	if query.To, err = appCtx.GetTimeParam(request, "to"); err != nil {
+		return query, utils.BadRequest("to 파라미터 형식이 잘못되었습니다")
+	}
+
+	return query, nil
+}
+
+// GetOrders는 매장, 고객, 상태, 주문 기간으로 주문 목록을 검색합니다.
+func (h *AdminHandler) GetOrders(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query, err := parseOrderQuery(request)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	resp, err := h.OrderService.GetOrders(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetOrder는 주문 상세를 조회합니다.
+func (h *AdminHandler) GetOrder(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	orderID := appCtx.GetParam(ctx, "id")
+	if orderID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 주문 ID입니다")), nil
+	}
+
+	result, err := h.OrderService.GetOrder(ctx, orderID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// CancelOrder는 CS 처리를 위해 주문을 강제 취소합니다.
+func (h *AdminHandler) CancelOrder(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	orderID := appCtx.GetParam(ctx, "id")
+	if orderID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 주문 ID입니다")), nil
+	}
+
+	var payload models.CancelOrderRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.OrderService.CancelOrder(ctx, h.Actor(ctx), orderID, payload.Reason)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
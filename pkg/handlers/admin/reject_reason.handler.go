@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// GetRejectReasonCodes는 거절 사유 코드 목록을 조회합니다.
+func (h *AdminHandler) GetRejectReasonCodes(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	includeInactive := appCtx.GetBoolParam(request, "includeInactive", false)
+
+	codes, err := h.RejectReasonService.GetRejectReasonCodes(ctx, includeInactive)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, codes), nil
+}
+
+// CreateRejectReasonCode는 거절 사유 코드를 추가합니다.
+func (h *AdminHandler) CreateRejectReasonCode(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var payload models.CreateRejectReasonCodeRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.RejectReasonService.CreateRejectReasonCode(ctx, h.Actor(ctx), &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusCreated, result), nil
+}
+
+// UpdateRejectReasonCode는 거절 사유 코드를 수정합니다.
+func (h *AdminHandler) UpdateRejectReasonCode(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	code := appCtx.GetParam(ctx, "code")
+	if code == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 거절 사유 코드입니다")), nil
+	}
+
+	var payload models.UpdateRejectReasonCodeRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.RejectReasonService.UpdateRejectReasonCode(ctx, h.Actor(ctx), code, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// DeactivateRejectReasonCode는 거절 사유 코드를 비활성화합니다.
+func (h *AdminHandler) DeactivateRejectReasonCode(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	code := appCtx.GetParam(ctx, "code")
+	if code == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 거절 사유 코드입니다")), nil
+	}
+
+	result, err := h.RejectReasonService.DeactivateRejectReasonCode(ctx, h.Actor(ctx), code)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
@@ -8,6 +8,8 @@ import (
 	"lambda-go/pkg/models"
 	"lambda-go/pkg/utils"
 	"net/http"
+	"strings"
+	"time"
 
 	dto "lambda-go/pkg/models/dtos"
 
@@ -18,20 +20,47 @@ type AdminHandler struct {
 	*handler.Handler
 }
 
-// GetRestaurantRequests는 매장 생성 요청 목록을 조회합니다.
-func (h *AdminHandler) GetRestaurantRequests(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+// parseRestaurantRequestQuery는 매장 요청 목록 필터를 파싱합니다. 목록 조회와 내보내기에서 함께 사용합니다.
+func (h *AdminHandler) parseRestaurantRequestQuery(ctx context.Context, request events.APIGatewayProxyRequest) dto.RestaurantRequestQuery {
 	query := dto.RestaurantRequestQuery{}
 
 	query.Page, query.PageSize = appCtx.ParsePaginationParams(request)
 
+	switch assignee := appCtx.GetStringParam(request, "assignee", ""); assignee {
+	case "":
+	case "unassigned":
+		query.Unassigned = true
+	case "me":
+		actorID := h.Actor(ctx).UserID
+		query.AssigneeID = &actorID
+	default:
+		query.AssigneeID = &assignee
+	}
+
+	switch appCtx.GetStringParam(request, "duplicate", "") {
+	case "true":
+		duplicate := true
+		query.Duplicate = &duplicate
+	case "false":
+		duplicate := false
+		query.Duplicate = &duplicate
+	}
+
 	statusStr := appCtx.GetStringParam(request, "status", "")
 	if statusStr != "" {
 		status := models.RestaurantRequestStatus(statusStr)
-		if status == models.PENDING || status == models.APPROVED || status == models.REJECTED {
+		if status == models.PENDING || status == models.APPROVED || status == models.REJECTED || status == models.WITHDRAWN {
 			query.Status = &status
 		}
 	}
 
+	return query
+}
+
+// GetRestaurantRequests는 매장 생성 요청 목록을 조회합니다.
+func (h *AdminHandler) GetRestaurantRequests(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query := h.parseRestaurantRequestQuery(ctx, request)
+
 	resp, err := h.AdminService.GetRestaurantRequests(ctx, query)
 	if err != nil {
 		return h.HandleAppError(err), nil
@@ -40,6 +69,73 @@ func (h *AdminHandler) GetRestaurantRequests(ctx context.Context, request events
 	return h.SuccessResponse(http.StatusOK, resp), nil
 }
 
+// GetRestaurantRequestStats는 매장 요청 큐의 SLA/처리 통계를 조회합니다.
+func (h *AdminHandler) GetRestaurantRequestStats(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	now := time.Now()
+	query := dto.RestaurantRequestStatsQuery{
+		From:      now.AddDate(0, 0, -30),
+		To:        now,
+		BreachMax: 20,
+	}
+
+	from, err := appCtx.GetTimeParam(request, "from")
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("from 파라미터 형식이 잘못되었습니다")), nil
+	}
+	if from != nil {
+		query.From = *from
+	}
+
+	to, err := appCtx.GetTimeParam(request, "to")
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("to 파라미터 형식이 잘못되었습니다")), nil
+	}
+	if to != nil {
+		query.To = *to
+	}
+
+	if slaHours := appCtx.GetIntParam(request, "slaHours", 0); slaHours > 0 {
+		query.SLA = time.Duration(slaHours) * time.Hour
+	}
+
+	resp, err := h.AdminService.GetRestaurantRequestStats(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetRestaurantRequest는 매장 요청 상세를 조회합니다.
+func (h *AdminHandler) GetRestaurantRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	requestID := appCtx.GetParam(ctx, "id")
+	if requestID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 요청 ID입니다")), nil
+	}
+
+	result, err := h.AdminService.GetRestaurantRequest(ctx, requestID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.WithETag(h.SuccessResponse(http.StatusOK, result), result.Version), nil
+}
+
+// VerifyRestaurantRequestLicense는 매장 요청의 사업자등록번호 상태를 다시 조회합니다.
+func (h *AdminHandler) VerifyRestaurantRequestLicense(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	requestID := appCtx.GetParam(ctx, "id")
+	if requestID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 요청 ID입니다")), nil
+	}
+
+	result, err := h.AdminService.VerifyRestaurantRequestLicense(ctx, requestID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.WithETag(h.SuccessResponse(http.StatusOK, result), result.Version), nil
+}
+
 // ProcessRestaurantRequest는 매장 생성 요청을 처리합니다.
 func (h *AdminHandler) ProcessRestaurantRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	requestID := appCtx.GetParam(ctx, "id")
@@ -58,7 +154,337 @@ func (h *AdminHandler) ProcessRestaurantRequest(ctx context.Context, request eve
 		return h.HandleAppError(utils.BadRequest(err.Error())), nil
 	}
 
-	result, err := h.AdminService.ProcessRestaurantRequest(ctx, requestID, &payload)
+	expectedVersion, err := appCtx.GetIfMatchVersion(request)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 If-Match 헤더입니다")), nil
+	}
+
+	result, err := h.AdminService.ProcessRestaurantRequest(ctx, h.Actor(ctx), requestID, &payload, expectedVersion)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.WithETag(h.SuccessResponse(http.StatusOK, result), result.Version), nil
+}
+
+// BulkProcessRestaurantRequests는 여러 매장 요청을 일괄 승인하거나 거절합니다.
+func (h *AdminHandler) BulkProcessRestaurantRequests(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var payload models.BulkProcessRestaurantRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.BulkProcessRestaurantRequests(ctx, h.Actor(ctx), &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// ClaimRestaurantRequest는 검토 대기 중인 매장 요청을 점유합니다.
+func (h *AdminHandler) ClaimRestaurantRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	requestID := appCtx.GetParam(ctx, "id")
+	if requestID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 요청 ID입니다")), nil
+	}
+
+	var payload models.ClaimRestaurantRequest
+
+	if request.Body != "" {
+		if err := json.Unmarshal([]byte(request.Body), &payload); err != nil {
+			return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+		}
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.ClaimRestaurantRequest(ctx, h.Actor(ctx), requestID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.WithETag(h.SuccessResponse(http.StatusOK, result), result.Version), nil
+}
+
+// ReleaseRestaurantRequest는 매장 요청의 점유를 해제합니다.
+func (h *AdminHandler) ReleaseRestaurantRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	requestID := appCtx.GetParam(ctx, "id")
+	if requestID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 요청 ID입니다")), nil
+	}
+
+	var payload models.ReleaseRestaurantRequest
+
+	if request.Body != "" {
+		if err := json.Unmarshal([]byte(request.Body), &payload); err != nil {
+			return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+		}
+	}
+
+	result, err := h.AdminService.ReleaseRestaurantRequest(ctx, h.Actor(ctx), requestID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.WithETag(h.SuccessResponse(http.StatusOK, result), result.Version), nil
+}
+
+// ReopenRestaurantRequest는 거절된 매장 요청을 다시 검토 대기 상태로 되돌립니다.
+func (h *AdminHandler) ReopenRestaurantRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	requestID := appCtx.GetParam(ctx, "id")
+	if requestID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 요청 ID입니다")), nil
+	}
+
+	expectedVersion, err := appCtx.GetIfMatchVersion(request)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 If-Match 헤더입니다")), nil
+	}
+
+	result, err := h.AdminService.ReopenRestaurantRequest(ctx, h.Actor(ctx), requestID, expectedVersion)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.WithETag(h.SuccessResponse(http.StatusOK, result), result.Version), nil
+}
+
+// WithdrawRestaurantRequest는 검토 대기 중인 매장 요청을 철회합니다.
+func (h *AdminHandler) WithdrawRestaurantRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	requestID := appCtx.GetParam(ctx, "id")
+	if requestID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 요청 ID입니다")), nil
+	}
+
+	expectedVersion, err := appCtx.GetIfMatchVersion(request)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 If-Match 헤더입니다")), nil
+	}
+
+	result, err := h.AdminService.WithdrawRestaurantRequest(ctx, h.Actor(ctx), requestID, expectedVersion)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.WithETag(h.SuccessResponse(http.StatusOK, result), result.Version), nil
+}
+
+// ChangeRestaurantStatus는 매장 상태를 변경합니다 (OPEN/CLOSE/HIDE).
+func (h *AdminHandler) ChangeRestaurantStatus(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	var payload models.ChangeRestaurantStatusRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.ChangeRestaurantStatus(ctx, h.Actor(ctx), restaurantID, payload.Action, payload.Reason)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// parseRestaurantQuery는 매장 목록 필터를 파싱합니다. 목록 조회와 내보내기에서 함께 사용합니다.
+func parseRestaurantQuery(request events.APIGatewayProxyRequest) dto.RestaurantQuery {
+	query := dto.RestaurantQuery{}
+
+	query.Page, query.PageSize = appCtx.ParsePaginationParams(request)
+
+	if search := strings.TrimSpace(appCtx.GetStringParam(request, "search", "")); search != "" {
+		query.Search = &search
+	}
+
+	if ownerID := appCtx.GetStringParam(request, "ownerId", ""); ownerID != "" {
+		query.OwnerID = &ownerID
+	}
+
+	statusStr := appCtx.GetStringParam(request, "status", "")
+	if statusStr != "" {
+		status := models.RestaurantStatus(statusStr)
+		if status == models.OPEN || status == models.CLOSED || status == models.HIDDEN {
+			query.Status = &status
+		}
+	}
+
+	switch deleted := dto.RestaurantDeletedFilter(appCtx.GetStringParam(request, "deleted", "")); deleted {
+	case dto.DELETED_INCLUDE, dto.DELETED_ONLY:
+		query.Deleted = deleted
+	default:
+		query.Deleted = dto.DELETED_EXCLUDE
+	}
+
+	return query
+}
+
+// GetRestaurants는 매장 목록을 검색합니다.
+func (h *AdminHandler) GetRestaurants(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query := parseRestaurantQuery(request)
+
+	resp, err := h.AdminService.GetRestaurants(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetRestaurant는 매장 상세를 조회합니다.
+func (h *AdminHandler) GetRestaurant(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	result, err := h.AdminService.GetRestaurant(ctx, restaurantID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// UpdateRestaurant는 매장 정보를 수정합니다.
+func (h *AdminHandler) UpdateRestaurant(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	var payload models.UpdateRestaurantRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.UpdateRestaurant(ctx, h.Actor(ctx), restaurantID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// DeleteRestaurant는 매장을 삭제(soft delete) 처리합니다.
+func (h *AdminHandler) DeleteRestaurant(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	var payload models.DeleteRestaurantRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.DeleteRestaurant(ctx, h.Actor(ctx), restaurantID, payload.Reason)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// RestoreRestaurant는 삭제된 매장을 복구합니다.
+func (h *AdminHandler) RestoreRestaurant(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	var payload models.DeleteRestaurantRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.RestoreRestaurant(ctx, h.Actor(ctx), restaurantID, payload.Reason)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// GetBusinessSchedule은 매장의 영업 일정과 영업 여부를 조회합니다.
+// at 파라미터(RFC3339)로 영업 여부를 계산할 시각을 지정하며, 생략하면 현재 시각을 사용합니다.
+func (h *AdminHandler) GetBusinessSchedule(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	at := time.Now()
+	atParam, err := appCtx.GetTimeParam(request, "at")
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("at 파라미터 형식이 잘못되었습니다")), nil
+	}
+	if atParam != nil {
+		at = *atParam
+	}
+
+	result, err := h.AdminService.GetBusinessSchedule(ctx, restaurantID, at)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// ReplaceBusinessSchedule은 매장의 영업 일정을 한 번에 교체합니다.
+func (h *AdminHandler) ReplaceBusinessSchedule(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	var payload models.ReplaceBusinessScheduleRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.AdminService.ReplaceBusinessSchedule(ctx, h.Actor(ctx), restaurantID, &payload)
 	if err != nil {
 		return h.HandleAppError(err), nil
 	}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// GetTags는 태그 목록을 사용 매장 수와 함께 조회합니다.
+func (h *AdminHandler) GetTags(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query := dto.TagQuery{Sort: dto.TAG_SORT_NAME}
+
+	query.Page, query.PageSize = appCtx.ParsePaginationParams(request)
+
+	if search := strings.TrimSpace(appCtx.GetStringParam(request, "search", "")); search != "" {
+		query.Search = &search
+	}
+
+	if dto.TagSort(appCtx.GetStringParam(request, "sort", "")) == dto.TAG_SORT_USAGE {
+		query.Sort = dto.TAG_SORT_USAGE
+	}
+
+	resp, err := h.TagService.GetTags(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetTag는 태그를 조회합니다.
+func (h *AdminHandler) GetTag(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	tagID, ok := tagIDParam(ctx, "id")
+	if !ok {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 태그 ID입니다")), nil
+	}
+
+	result, err := h.TagService.GetTag(ctx, tagID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// CreateTag는 태그를 추가합니다.
+func (h *AdminHandler) CreateTag(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var payload models.CreateTagRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.TagService.CreateTag(ctx, h.Actor(ctx), &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusCreated, result), nil
+}
+
+// UpdateTag는 태그의 이름과 설명을 변경합니다.
+func (h *AdminHandler) UpdateTag(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	tagID, ok := tagIDParam(ctx, "id")
+	if !ok {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 태그 ID입니다")), nil
+	}
+
+	var payload models.UpdateTagRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.TagService.UpdateTag(ctx, h.Actor(ctx), tagID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// DeleteTag는 태그와 태그의 매장 연결을 삭제합니다.
+func (h *AdminHandler) DeleteTag(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	tagID, ok := tagIDParam(ctx, "id")
+	if !ok {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 태그 ID입니다")), nil
+	}
+
+	result, err := h.TagService.DeleteTag(ctx, h.Actor(ctx), tagID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// MergeTags는 원본 태그들을 경로의 대상 태그로 합칩니다.
+func (h *AdminHandler) MergeTags(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	tagID, ok := tagIDParam(ctx, "id")
+	if !ok {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 태그 ID입니다")), nil
+	}
+
+	var payload models.MergeTagsRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.TagService.MergeTags(ctx, h.Actor(ctx), tagID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// GetRestaurantTags는 매장에 붙은 태그를 조회합니다.
+func (h *AdminHandler) GetRestaurantTags(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	result, err := h.TagService.GetRestaurantTags(ctx, restaurantID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// AssignRestaurantTags는 매장에 태그들을 붙입니다.
+func (h *AdminHandler) AssignRestaurantTags(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	var payload models.AssignRestaurantTagsRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.TagService.AssignRestaurantTags(ctx, h.Actor(ctx), restaurantID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// RemoveRestaurantTag는 매장에서 태그를 뗍니다.
+func (h *AdminHandler) RemoveRestaurantTag(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	restaurantID := appCtx.GetParam(ctx, "id")
+	if restaurantID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 매장 ID입니다")), nil
+	}
+
+	tagID, ok := tagIDParam(ctx, "tagId")
+	if !ok {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 태그 ID입니다")), nil
+	}
+
+	result, err := h.TagService.RemoveRestaurantTag(ctx, h.Actor(ctx), restaurantID, tagID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// tagIDParam은 경로 파라미터에서 양의 정수 태그 ID를 읽습니다.
+func tagIDParam(ctx context.Context, name string) (int, bool) {
+	tagID, err := strconv.Atoi(appCtx.GetParam(ctx, name))
+	if err != nil || tagID < 1 {
+		return 0, false
+	}
+	return tagID, true
+}
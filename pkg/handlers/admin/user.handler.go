@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	appCtx "lambda-go/pkg/contexts"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+	"net/http"
+
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// GetUsers는 이메일, 이름, 역할, 정지 여부로 사용자 목록을 검색합니다.
+func (h *AdminHandler) GetUsers(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	query := dto.UserQuery{}
+
+	query.Page, query.PageSize = appCtx.ParsePaginationParams(request)
+
+	if search := appCtx.GetStringParam(request, "search", ""); search != "" {
+		query.Search = &search
+	}
+
+	roleStr := appCtx.GetStringParam(request, "role", "")
+	if roleStr != "" {
+		role := models.Role(roleStr)
+		switch role {
+		case models.CUSTOMER, models.OWNER, models.ADMIN:
+			query.Role = &role
+		}
+	}
+
+	if suspendedStr := appCtx.GetStringParam(request, "suspended", ""); suspendedStr != "" {
+		suspended := appCtx.GetBoolParam(request, "suspended", false)
+		query.Suspended = &suspended
+	}
+
+	resp, err := h.UserService.GetUsers(ctx, query)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, resp), nil
+}
+
+// GetUser는 사용자 상세를 조회합니다.
+func (h *AdminHandler) GetUser(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	userID := appCtx.GetParam(ctx, "id")
+	if userID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 사용자 ID입니다")), nil
+	}
+
+	result, err := h.UserService.GetUser(ctx, userID)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// SuspendUser는 사용자 계정을 정지합니다.
+func (h *AdminHandler) SuspendUser(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	return h.changeUserSuspension(ctx, request, true)
+}
+
+// UnsuspendUser는 사용자 계정 정지를 해제합니다.
+func (h *AdminHandler) UnsuspendUser(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	return h.changeUserSuspension(ctx, request, false)
+}
+
+// changeUserSuspension은 계정 정지/정지 해제 요청을 처리합니다.
+func (h *AdminHandler) changeUserSuspension(ctx context.Context, request events.APIGatewayProxyRequest, suspend bool) (events.APIGatewayProxyResponse, error) {
+	userID := appCtx.GetParam(ctx, "id")
+	if userID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 사용자 ID입니다")), nil
+	}
+
+	var payload models.SuspendUserRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	var result *models.User
+	if suspend {
+		result, err = h.UserService.SuspendUser(ctx, h.Actor(ctx), userID, payload.Reason)
+	} else {
+		result, err = h.UserService.UnsuspendUser(ctx, h.Actor(ctx), userID, payload.Reason)
+	}
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
+
+// ChangeUserRole은 사용자 역할을 변경합니다.
+func (h *AdminHandler) ChangeUserRole(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	userID := appCtx.GetParam(ctx, "id")
+	if userID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 사용자 ID입니다")), nil
+	}
+
+	var payload models.ChangeUserRoleRequest
+
+	err := json.Unmarshal([]byte(request.Body), &payload)
+	if err != nil {
+		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다: " + err.Error())), nil
+	}
+
+	if err := utils.Validate(&payload); err != nil {
+		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+	}
+
+	result, err := h.UserService.ChangeUserRole(ctx, h.Actor(ctx), userID, &payload)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, result), nil
+}
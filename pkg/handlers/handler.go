@@ -1,8 +1,13 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	config "lambda-go/pkg/configs"
+	appCtx "lambda-go/pkg/contexts"
+	middleware "lambda-go/pkg/middlewares"
+	"lambda-go/pkg/models"
 	adminService "lambda-go/pkg/services/admin"
 	publicService "lambda-go/pkg/services/public"
 	"lambda-go/pkg/utils"
@@ -13,20 +18,54 @@ import (
 
 // Handler는 Lambda 핸들러 구조체입니다.
 type Handler struct {
-	config       *config.Config
-	S3Service    *publicService.S3Service
-	AdminService *adminService.RestaurantService
+	config              *config.Config
+	S3Service           *publicService.S3Service
+	UploadService       *publicService.UploadService
+	AdminService        *adminService.RestaurantService
+	AuditService        *adminService.AuditService
+	RejectReasonService *adminService.RejectReasonService
+	TagService          *adminService.TagService
+	OrderService        *adminService.OrderService
+	AnalyticsService    *adminService.AnalyticsService
+	UserService         *adminService.UserService
+	ExportService       *adminService.ExportService
+	ImportService       *adminService.ImportService
 }
 
 // NewHandler는 새 Handler 인스턴스를 생성합니다.
-func NewHandler(cfg *config.Config, s3Svc *publicService.S3Service, adminSvc *adminService.RestaurantService) *Handler {
+func NewHandler(cfg *config.Config, s3Svc *publicService.S3Service, uploadSvc *publicService.UploadService, adminSvc *adminService.RestaurantService, auditSvc *adminService.AuditService, rejectReasonSvc *adminService.RejectReasonService, tagSvc *adminService.TagService, orderSvc *adminService.OrderService, analyticsSvc *adminService.AnalyticsService, userSvc *adminService.UserService, exportSvc *adminService.ExportService, importSvc *adminService.ImportService) *Handler {
 	return &Handler{
-		config:       cfg,
-		S3Service:    s3Svc,
-		AdminService: adminSvc,
+		config:              cfg,
+		S3Service:           s3Svc,
+		UploadService:       uploadSvc,
+		AdminService:        adminSvc,
+		AuditService:        auditSvc,
+		RejectReasonService: rejectReasonSvc,
+		TagService:          tagSvc,
+		OrderService:        orderSvc,
+		AnalyticsService:    analyticsSvc,
+		UserService:         userSvc,
+		ExportService:       exportSvc,
+		ImportService:       importSvc,
 	}
 }
 
+// Actor는 인증 클레임과 요청 메타데이터로 감사 로그 행위자 정보를 구성합니다.
+func (h *Handler) Actor(ctx context.Context) models.AuditActor {
+	meta := appCtx.GetRequestMeta(ctx)
+	actor := models.AuditActor{
+		IP:        meta.IP,
+		UserAgent: meta.UserAgent,
+		RequestID: meta.RequestID,
+	}
+
+	if claims, ok := middleware.GetClaimsFromContext(ctx); ok {
+		actor.UserID = claims.UserID
+		actor.Role = claims.Role
+	}
+	return actor
+}
+
 // corsResponse는 CORS 헤더가 포함된 응답을 생성합니다.
 func (h *Handler) CorsResponse(response events.APIGatewayProxyResponse) events.APIGatewayProxyResponse {
 	// 기존 헤더 유지하면서 CORS 헤더 추가
@@ -35,12 +74,22 @@ func (h *Handler) CorsResponse(response events.APIGatewayProxyResponse) events.A
 	}
 
 	response.Headers["Access-Control-Allow-Origin"] = "*"
-	response.Headers["Access-Control-Allow-Headers"] = "Content-Type,X-Amz-Date,Authorization,X-Api-Key,X-Amz-Security-Token"
-	response.Headers["Access-Control-Allow-Methods"] = "GET,POST,OPTIONS"
+	response.Headers["Access-Control-Allow-Headers"] = "Content-Type,X-Amz-Date,Authorization,X-Api-Key,X-Amz-Security-Token,If-Match"
+	response.Headers["Access-Control-Allow-Methods"] = "GET,POST,PUT,PATCH,DELETE,OPTIONS"
+	response.Headers["Access-Control-Expose-Headers"] = "ETag"
 
 	return response
 }
 
+// WithETag는 응답에 버전 기반 ETag 헤더를 추가합니다.
+func (h *Handler) WithETag(response events.APIGatewayProxyResponse, version int) events.APIGatewayProxyResponse {
+	if response.Headers == nil {
+		response.Headers = make(map[string]string)
+	}
+	response.Headers["ETag"] = fmt.Sprintf(`"%d"`, version)
+	return response
+}
+
 // successResponse는 성공 응답을 생성합니다.
 func (h *Handler) SuccessResponse(statusCode int, data interface{}) events.APIGatewayProxyResponse {
 	response, err := utils.Success(statusCode, data)
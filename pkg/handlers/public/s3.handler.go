@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	appCtx "lambda-go/pkg/contexts"
 	handler "lambda-go/pkg/handlers"
 	"lambda-go/pkg/models"
 	"lambda-go/pkg/utils"
@@ -31,11 +32,12 @@ func (h *S3Handler) GetPresignedURL(ctx context.Context, request events.APIGatew
 		return h.HandleAppError(utils.BadRequest("잘못된 요청 형식입니다", err)), nil
 	}
 
-	// 요청 검증 및 전처리
-	err = h.S3Service.ValidateAndPreprocessRequest(&req)
+	// 발급 정책에 따른 요청 검증 및 전처리
+	actor := h.Actor(ctx)
+	err = h.S3Service.ValidateAndPreprocessRequest(&req, actor.UserID, actor.Role)
 	if err != nil {
 		log.Printf("요청 검증 오류: %v", err)
-		return h.HandleAppError(utils.BadRequest(err.Error())), nil
+		return h.HandleAppError(err), nil
 	}
 
 	// Presigned URL 생성
@@ -44,5 +46,29 @@ func (h *S3Handler) GetPresignedURL(ctx context.Context, request events.APIGatew
 		log.Printf("Presigned URL 생성 오류: %v", err)
 		return h.HandleAppError(utils.InternalServerError("Presigned URL 생성 중 오류가 발생했습니다", err)), nil
 	}
+
+	// 업로드 URL은 등록부에 기록해 확인/정리 대상으로 관리
+	if req.Method == "PUT" || req.Method == "POST" {
+		if err := h.UploadService.Register(ctx, actor.UserID, &req, resp); err != nil {
+			log.Printf("업로드 등록 오류: %v", err)
+			return h.HandleAppError(err), nil
+		}
+	}
 	return h.SuccessResponse(200, resp), nil
+}
+
+// ConfirmUpload는 업로드한 파일을 확인하고 크기와 ETag를 등록부에 기록합니다.
+func (h *S3Handler) ConfirmUpload(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	uploadID := appCtx.GetParam(ctx, "id")
+	if uploadID == "" {
+		return h.HandleAppError(utils.BadRequest("유효하지 않은 업로드 ID입니다")), nil
+	}
+
+	actor := h.Actor(ctx)
+	upload, err := h.UploadService.Confirm(ctx, uploadID, actor.UserID, actor.Role)
+	if err != nil {
+		return h.HandleAppError(err), nil
+	}
+
+	return h.SuccessResponse(http.StatusOK, upload), nil
 }
\ No newline at end of file
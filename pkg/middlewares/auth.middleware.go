@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	config "lambda-go/pkg/configs"
+	appCtx "lambda-go/pkg/contexts"
 	"lambda-go/pkg/models"
 	"lambda-go/pkg/utils"
 	"strings"
@@ -61,7 +62,7 @@ func SessionAuth(db *sql.DB, next func(context.Context, events.APIGatewayProxyRe
 			return events.APIGatewayProxyResponse{}, utils.Unauthorized("관리자 권한이 없습니다")
 		}
 		// 세션 토큰 검증
-		clientIP, err := getClientIP(request)
+		clientIP, err := appCtx.GetClientIP(request)
 		if err != nil {
 			return events.APIGatewayProxyResponse{}, utils.Unauthorized(fmt.Sprintf("정상적인 로그인이 아닙니다. 새로운 환경에서 다시 시도해주세요: %s", err.Error()))
 		}
@@ -70,6 +71,9 @@ func SessionAuth(db *sql.DB, next func(context.Context, events.APIGatewayProxyRe
 			return events.APIGatewayProxyResponse{}, utils.Unauthorized(fmt.Sprintf("세션 검증 실패: %s", err.Error()))
 		}
 
+		// 토큰의 클레임 정보를 컨텍스트에 추가 (감사 로그의 행위자 식별용)
+		ctx = context.WithValue(ctx, ClaimsKey, claims)
+
 		// 다음 핸들러 호출
 		response, err := next(ctx, request)
 		if err != nil {
@@ -79,14 +83,15 @@ func SessionAuth(db *sql.DB, next func(context.Context, events.APIGatewayProxyRe
 	}
 }
 
-// validateAdminSession은 세션 토큰의 유효성을 검증합니다
+// validateAdminSession은 세션 토큰의 유효성을 검증합니다 (정지된 계정의 세션은 거부)
 func validateAdminSession(ctx context.Context, db *sql.DB, userID string, token string, clientIP string) (bool, error) {
 	var session AdminSession
 
 	query := `
-    SELECT "userId", "token", "ip"
-    FROM "AdminSession"
-    WHERE "userId" = $1
+    SELECT s."userId", s."token", s."ip"
+    FROM "AdminSession" s
+    JOIN "User" u ON u."id" = s."userId"
+    WHERE s."userId" = $1 AND u."suspendedAt" IS NULL
 	`
 	err := db.QueryRowContext(ctx, query, userID).Scan(&session.UserID, &session.Token, &session.IP)
 	
@@ -136,22 +141,6 @@ func parseCookies(cookieHeader string) map[string]string {
 	return cookies
 }
 
-func getClientIP(request events.APIGatewayProxyRequest) (string, error) {
-	// API Gateway 프록시 요청에서 클라이언트 IP 추출
-	if ip, ok := request.Headers["X-Forwarded-For"]; ok && ip != "" {
-		// X-Forwarded-For는 콤마로 구분된 IP 목록일 수 있으므로 첫 번째 IP 사용
-		ips := strings.Split(ip, ",")
-		return strings.TrimSpace(ips[0]), nil
-	}
-	
-	// 헤더에 없다면 요청 컨텍스트에서 추출 시도
-	if request.RequestContext.Identity.SourceIP != "" {
-		return request.RequestContext.Identity.SourceIP, nil
-	}
-	
-	return "", errors.New("클라이언트 IP 추출 실패")
-}
-
 // DefaultAuth는 기본 JWT 토큰 검증만 수행하는 미들웨어입니다
 func DefaultAuth(next func(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)) func(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, *utils.AppError) {
 	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, *utils.AppError) {
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// AnalyticsInterval은 매출 집계 구간 단위입니다. 구간 경계는 Asia/Seoul 기준입니다.
+type AnalyticsInterval string
+
+const (
+	INTERVAL_DAY   AnalyticsInterval = "day"
+	INTERVAL_WEEK  AnalyticsInterval = "week" // 월요일 시작
+	INTERVAL_MONTH AnalyticsInterval = "month"
+)
+
+// MaxAnalyticsBuckets는 한 번에 조회할 수 있는 최대 집계 구간 수입니다.
+const MaxAnalyticsBuckets = 400
+
+// Truncate는 시각이 속한 구간의 시작 시각(Asia/Seoul 자정)을 반환합니다.
+func (i AnalyticsInterval) Truncate(t time.Time) time.Time {
+	local := t.In(SeoulLocation)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, SeoulLocation)
+	switch i {
+	case INTERVAL_WEEK:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case INTERVAL_MONTH:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, SeoulLocation)
+	default:
+		return day
+	}
+}
+
+// Next는 구간 시작 시각의 다음 구간 시작 시각을 반환합니다.
+func (i AnalyticsInterval) Next(start time.Time) time.Time {
+	switch i {
+	case INTERVAL_WEEK:
+		return start.AddDate(0, 0, 7)
+	case INTERVAL_MONTH:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Buckets는 [from, to) 기간에 걸친 구간 시작 시각 목록을 반환합니다.
+// 구간 수가 MaxAnalyticsBuckets를 넘으면 에러를 반환합니다.
+func (i AnalyticsInterval) Buckets(from, to time.Time) ([]time.Time, error) {
+	var buckets []time.Time
+	for start := i.Truncate(from); start.Before(to); start = i.Next(start) {
+		if len(buckets) == MaxAnalyticsBuckets {
+			return nil, fmt.Errorf("집계 구간이 너무 많습니다 (최대 %d개)", MaxAnalyticsBuckets)
+		}
+		buckets = append(buckets, start)
+	}
+	return buckets, nil
+}
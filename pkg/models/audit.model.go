@@ -0,0 +1,131 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AuditAction은 감사 로그에 기록되는 관리자 행위를 나타내는 열거형입니다.
+type AuditAction string
+
+const (
+	AUDIT_REQUEST_APPROVE          AuditAction = "RESTAURANT_REQUEST_APPROVE"
+	AUDIT_REQUEST_REJECT           AuditAction = "RESTAURANT_REQUEST_REJECT"
+	AUDIT_REQUEST_REOPEN           AuditAction = "RESTAURANT_REQUEST_REOPEN"
+	AUDIT_REQUEST_WITHDRAW         AuditAction = "RESTAURANT_REQUEST_WITHDRAW"
+	AUDIT_REQUEST_CLAIM            AuditAction = "RESTAURANT_REQUEST_CLAIM"
+	AUDIT_REQUEST_RELEASE          AuditAction = "RESTAURANT_REQUEST_RELEASE"
+	AUDIT_REQUEST_LICENSE_OVERRIDE AuditAction = "RESTAURANT_REQUEST_LICENSE_OVERRIDE"
+	AUDIT_RESTAURANT_STATUS_CHANGE AuditAction = "RESTAURANT_STATUS_CHANGE"
+	AUDIT_RESTAURANT_UPDATE        AuditAction = "RESTAURANT_UPDATE"
+	AUDIT_RESTAURANT_DELETE        AuditAction = "RESTAURANT_DELETE"
+	AUDIT_RESTAURANT_RESTORE       AuditAction = "RESTAURANT_RESTORE"
+	AUDIT_RESTAURANT_IMPORT        AuditAction = "RESTAURANT_IMPORT"
+	AUDIT_BUSINESS_SCHEDULE_UPDATE AuditAction = "BUSINESS_SCHEDULE_UPDATE"
+	AUDIT_MENU_UPDATE              AuditAction = "RESTAURANT_MENU_UPDATE"
+	AUDIT_MENU_HIDE_ALL            AuditAction = "RESTAURANT_MENU_HIDE_ALL"
+	AUDIT_TAG_CREATE               AuditAction = "TAG_CREATE"
+	AUDIT_TAG_UPDATE               AuditAction = "TAG_UPDATE"
+	AUDIT_TAG_DELETE               AuditAction = "TAG_DELETE"
+	AUDIT_TAG_MERGE                AuditAction = "TAG_MERGE"
+	AUDIT_RESTAURANT_TAG_ASSIGN    AuditAction = "RESTAURANT_TAG_ASSIGN"
+	AUDIT_RESTAURANT_TAG_REMOVE    AuditAction = "RESTAURANT_TAG_REMOVE"
+	AUDIT_ORDER_CANCEL             AuditAction = "ORDER_CANCEL"
+	AUDIT_DELIVERY_STATUS_CORRECT  AuditAction = "ORDER_DELIVERY_STATUS_CORRECT"
+	AUDIT_USER_SUSPEND             AuditAction = "USER_SUSPEND"
+	AUDIT_USER_UNSUSPEND           AuditAction = "USER_UNSUSPEND"
+	AUDIT_USER_ROLE_CHANGE         AuditAction = "USER_ROLE_CHANGE"
+	AUDIT_REJECT_REASON_CREATE     AuditAction = "REJECT_REASON_CREATE"
+	AUDIT_REJECT_REASON_UPDATE     AuditAction = "REJECT_REASON_UPDATE"
+	AUDIT_REJECT_REASON_DEACTIVATE AuditAction = "REJECT_REASON_DEACTIVATE"
+)
+
+// requestAuditActions는 매장 요청 행위별 감사 로그 행위입니다.
+var requestAuditActions = map[RestaurantRequestAction]AuditAction{
+	REQUEST_APPROVE:  AUDIT_REQUEST_APPROVE,
+	REQUEST_REJECT:   AUDIT_REQUEST_REJECT,
+	REQUEST_REOPEN:   AUDIT_REQUEST_REOPEN,
+	REQUEST_WITHDRAW: AUDIT_REQUEST_WITHDRAW,
+}
+
+// AuditActionOf는 매장 요청 행위에 해당하는 감사 로그 행위를 반환합니다.
+func AuditActionOf(action RestaurantRequestAction) AuditAction {
+	if auditAction, ok := requestAuditActions[action]; ok {
+		return auditAction
+	}
+	return AuditAction("RESTAURANT_REQUEST_" + string(action))
+}
+
+// AuditTargetType은 감사 로그 대상 유형을 나타내는 열거형입니다.
+type AuditTargetType string
+
+const (
+	AUDIT_TARGET_RESTAURANT_REQUEST AuditTargetType = "RESTAURANT_REQUEST"
+	AUDIT_TARGET_RESTAURANT         AuditTargetType = "RESTAURANT"
+	AUDIT_TARGET_RESTAURANT_MENU    AuditTargetType = "RESTAURANT_MENU"
+	AUDIT_TARGET_TAG                AuditTargetType = "TAG"
+	AUDIT_TARGET_ORDER              AuditTargetType = "ORDER"
+	AUDIT_TARGET_USER               AuditTargetType = "USER"
+	AUDIT_TARGET_REJECT_REASON      AuditTargetType = "REJECT_REASON_CODE"
+)
+
+// AuditActor는 행위를 수행한 관리자와 요청 정보입니다.
+type AuditActor struct {
+	UserID    string
+	Role      Role
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+// AuditLog는 추가만 가능한 관리자 행위 감사 로그 모델입니다.
+type AuditLog struct {
+	ID         int64           `json:"id" db:"id"`
+	ActorID    string          `json:"actorId" db:"actorId"`
+	ActorRole  string          `json:"actorRole,omitempty" db:"actorRole"`
+	Action     AuditAction     `json:"action" db:"action"`
+	TargetType AuditTargetType `json:"targetType" db:"targetType"`
+	TargetID   string          `json:"targetId" db:"targetId"`
+	Before     json.RawMessage `json:"before,omitempty" db:"before"` // 변경 전 스냅샷
+	After      json.RawMessage `json:"after,omitempty" db:"after"`   // 변경 후 스냅샷
+	Reason     *string         `json:"reason,omitempty" db:"reason"`
+	IP         string          `json:"ip,omitempty" db:"ip"`
+	UserAgent  string          `json:"userAgent,omitempty" db:"userAgent"`
+	RequestID  string          `json:"requestId,omitempty" db:"requestId"`
+	CreatedAt  time.Time       `json:"createdAt" db:"createdAt"`
+}
+
+// NewAuditLog는 변경 전후 스냅샷을 JSON으로 직렬화하여 감사 로그를 생성합니다.
+// before나 after가 nil이면 해당 스냅샷은 비워둡니다.
+func NewAuditLog(actor AuditActor, action AuditAction, targetType AuditTargetType, targetID string, before, after interface{}) (*AuditLog, error) {
+	entry := &AuditLog{
+		ActorID:    actor.UserID,
+		ActorRole:  string(actor.Role),
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		IP:         actor.IP,
+		UserAgent:  actor.UserAgent,
+		RequestID:  actor.RequestID,
+	}
+
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return nil, fmt.Errorf("변경 전 스냅샷 직렬화 실패: %w", err)
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return nil, fmt.Errorf("변경 후 스냅샷 직렬화 실패: %w", err)
+		}
+	}
+	return entry, nil
+}
+
+// WithReason은 감사 로그에 사유를 설정합니다.
+func (a *AuditLog) WithReason(reason *string) *AuditLog {
+	a.Reason = reason
+	return a
+}
@@ -0,0 +1,165 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DeliveryInfo는 배달 유형별 배달 정보입니다. OrderDelivery.deliveryInfo JSON 컬럼을 배달 유형에 맞게 디코딩한 값입니다.
+type DeliveryInfo interface {
+	DeliveryType() DeliveryType
+	Validate() error
+}
+
+// SelfDeliveryInfo는 자체배달(SELF) 배달 정보입니다.
+type SelfDeliveryInfo struct {
+	RiderName     string  `json:"riderName"`
+	RiderPhone    string  `json:"riderPhone"`
+	Address       string  `json:"address"`
+	AddressDetail *string `json:"addressDetail,omitempty"`
+	Memo          *string `json:"memo,omitempty"`
+}
+
+// AgencyDeliveryInfo는 배달대행(DELIVERY) 배달 정보입니다.
+type AgencyDeliveryInfo struct {
+	Agency        string  `json:"agency"`     // 배달대행사
+	TrackingID    string  `json:"trackingId"` // 배달대행사 배차 번호
+	TrackingURL   *string `json:"trackingUrl,omitempty"`
+	RiderName     *string `json:"riderName,omitempty"`
+	RiderPhone    *string `json:"riderPhone,omitempty"`
+	Address       string  `json:"address"`
+	AddressDetail *string `json:"addressDetail,omitempty"`
+	Memo          *string `json:"memo,omitempty"`
+}
+
+// PickupInfo는 픽업(PICKUP) 배달 정보입니다.
+type PickupInfo struct {
+	PickupCode *string `json:"pickupCode,omitempty"` // 매장에서 확인하는 픽업 번호
+	Memo       *string `json:"memo,omitempty"`
+}
+
+func (SelfDeliveryInfo) DeliveryType() DeliveryType   { return SELF }
+func (AgencyDeliveryInfo) DeliveryType() DeliveryType { return DELIVERY }
+func (PickupInfo) DeliveryType() DeliveryType         { return PICKUP }
+
+// Validate는 자체배달 정보의 필수 항목을 검증합니다.
+func (i SelfDeliveryInfo) Validate() error {
+	return requireFields(
+		deliveryField{"riderName", i.RiderName},
+		deliveryField{"riderPhone", i.RiderPhone},
+		deliveryField{"address", i.Address},
+	)
+}
+
+// Validate는 배달대행 정보의 필수 항목을 검증합니다.
+func (i AgencyDeliveryInfo) Validate() error {
+	return requireFields(
+		deliveryField{"agency", i.Agency},
+		deliveryField{"trackingId", i.TrackingID},
+		deliveryField{"address", i.Address},
+	)
+}
+
+// Validate는 픽업 정보를 검증합니다. 필수 항목이 없습니다.
+func (i PickupInfo) Validate() error {
+	return nil
+}
+
+// deliveryField는 필수 항목 검증에 사용하는 배달 정보 항목 이름과 값입니다.
+type deliveryField struct {
+	name  string
+	value string
+}
+
+// requireFields는 비어 있는 필수 항목을 모아 에러로 반환합니다.
+func requireFields(fields ...deliveryField) error {
+	var missing []string
+	for _, field := range fields {
+		if strings.TrimSpace(field.value) == "" {
+			missing = append(missing, field.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("배달 정보에 필수 항목이 없습니다: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// DecodeDeliveryInfo는 배달 유형에 맞는 배달 정보 구조체로 JSON을 디코딩하고 검증합니다.
+// 배달 정보가 비어 있으면 nil을 반환하며, 배달 유형 없이 배달 정보만 있으면 에러를 반환합니다.
+func DecodeDeliveryInfo(deliveryType *DeliveryType, raw []byte) (DeliveryInfo, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, nil
+	}
+	if deliveryType == nil {
+		return nil, errors.New("배달 유형 없이 배달 정보가 저장되어 있습니다")
+	}
+
+	var info DeliveryInfo
+	switch *deliveryType {
+	case SELF:
+		var v SelfDeliveryInfo
+		if err := json.Unmarshal(trimmed, &v); err != nil {
+			return nil, fmt.Errorf("자체배달 정보 형식이 잘못되었습니다: %w", err)
+		}
+		info = v
+	case DELIVERY:
+		var v AgencyDeliveryInfo
+		if err := json.Unmarshal(trimmed, &v); err != nil {
+			return nil, fmt.Errorf("배달대행 정보 형식이 잘못되었습니다: %w", err)
+		}
+		info = v
+	case PICKUP:
+		var v PickupInfo
+		if err := json.Unmarshal(trimmed, &v); err != nil {
+			return nil, fmt.Errorf("픽업 정보 형식이 잘못되었습니다: %w", err)
+		}
+		info = v
+	default:
+		return nil, fmt.Errorf("알 수 없는 배달 유형입니다: %s", *deliveryType)
+	}
+
+	if err := info.Validate(); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// InFlightDelivery는 배달 중(DELIVERING)인 주문 배달과 경과 시간입니다.
+// 경과 시간과 예상 시간(estimatedTime, 분)은 배달 정보 생성 시각부터 계산합니다.
+type InFlightDelivery struct {
+	OrderDelivery
+	RestaurantID   string      `json:"restaurantId"`
+	CustomerID     string      `json:"customerId"`
+	OrderStatus    OrderStatus `json:"orderStatus"`
+	AgeMinutes     int         `json:"ageMinutes"`
+	ExpectedAt     *time.Time  `json:"expectedAt,omitempty"`     // 예상 도착 시각 (estimatedTime이 있을 때)
+	OverdueMinutes int         `json:"overdueMinutes,omitempty"` // 예상 도착 시각을 넘긴 시간 (분)
+	Overdue        bool        `json:"overdue"`
+}
+
+// NewInFlightDelivery는 기준 시각으로 배달 경과 시간과 예상 시간 초과 여부를 계산합니다.
+func NewInFlightDelivery(delivery OrderDelivery, order Order, now time.Time) InFlightDelivery {
+	item := InFlightDelivery{
+		OrderDelivery: delivery,
+		RestaurantID:  order.RestaurantID,
+		CustomerID:    order.CustomerID,
+		OrderStatus:   order.Status,
+		AgeMinutes:    int(now.Sub(delivery.CreatedAt) / time.Minute),
+	}
+
+	if delivery.EstimatedTime != nil {
+		expectedAt := delivery.CreatedAt.Add(time.Duration(*delivery.EstimatedTime) * time.Minute)
+		item.ExpectedAt = &expectedAt
+		if now.After(expectedAt) {
+			item.Overdue = true
+			item.OverdueMinutes = int(now.Sub(expectedAt) / time.Minute)
+		}
+	}
+	return item
+}
@@ -0,0 +1,26 @@
+package dtos
+
+import (
+	"lambda-go/pkg/models"
+	"time"
+)
+
+// AnalyticsSort는 매장/메뉴 순위 정렬 기준입니다.
+type AnalyticsSort string
+
+const (
+	ANALYTICS_SORT_GMV    AnalyticsSort = "gmv"    // GMV 높은 순 (기본값)
+	ANALYTICS_SORT_ORDERS AnalyticsSort = "orders" // 주문 수 많은 순
+)
+
+// AnalyticsQuery는 매출/주문 분석 조회를 위한 쿼리 파라미터 DTO입니다.
+// 기간은 [From, To)이며 날짜만 지정하면 Asia/Seoul 자정으로 해석합니다.
+type AnalyticsQuery struct {
+	From         time.Time                `json:"from"`
+	To           time.Time                `json:"to"`
+	Interval     models.AnalyticsInterval `json:"interval,omitempty"`
+	RestaurantID *string                  `json:"restaurantId,omitempty"`
+	Sort         AnalyticsSort            `json:"sort,omitempty"`
+	Limit        int                      `json:"limit,omitempty"`
+	MinOrders    int                      `json:"minOrders,omitempty"` // 취소/거절 비율 집계 시 최소 주문 수
+}
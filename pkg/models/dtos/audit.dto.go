@@ -0,0 +1,18 @@
+package dtos
+
+import (
+	"lambda-go/pkg/models"
+	"time"
+)
+
+// AuditLogQuery는 감사 로그 조회를 위한 쿼리 파라미터 DTO입니다.
+type AuditLogQuery struct {
+	ActorID    string                 `json:"actorId,omitempty"`
+	Action     models.AuditAction     `json:"action,omitempty"`
+	TargetType models.AuditTargetType `json:"targetType,omitempty"`
+	TargetID   string                 `json:"targetId,omitempty"`
+	From       *time.Time             `json:"from,omitempty"`
+	To         *time.Time             `json:"to,omitempty"`
+	Cursor     *int64                 `json:"cursor,omitempty"` // 이전 페이지 마지막 로그 ID
+	Limit      int                    `json:"limit"`
+}
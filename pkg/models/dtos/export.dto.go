@@ -0,0 +1,10 @@
+package dtos
+
+import "lambda-go/pkg/models"
+
+// ExportOptions는 목록 내보내기 공통 옵션 DTO입니다. 목록 필터는 각 목록의 Query DTO를 그대로 사용합니다.
+type ExportOptions struct {
+	Format   models.ExportFormat `json:"format"`
+	Columns  []string            `json:"columns,omitempty"` // 내보낼 열 키 (비어 있으면 전체)
+	Download bool                `json:"download"`          // 행 수와 관계없이 S3 다운로드 URL로 응답
+}
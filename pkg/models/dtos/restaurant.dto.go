@@ -1,10 +1,89 @@
 package dtos
 
-import "lambda-go/pkg/models"
+import (
+	"lambda-go/pkg/models"
+	"time"
+)
 
 // RestaurantRequestQuery는 매장 요청 조회를 위한 쿼리 파라미터 DTO입니다.
 type RestaurantRequestQuery struct {
-	Page     int                             `json:"page"`
-	PageSize int                             `json:"pageSize"`
-	Status   *models.RestaurantRequestStatus `json:"status,omitempty"`
+	Page       int                             `json:"page"`
+	PageSize   int                             `json:"pageSize"`
+	Status     *models.RestaurantRequestStatus `json:"status,omitempty"`
+	AssigneeID *string                         `json:"assigneeId,omitempty"` // 해당 검토자가 점유 중인 요청만 조회
+	Unassigned bool                            `json:"unassigned,omitempty"` // 미점유 또는 점유 만료 요청만 조회
+	Duplicate  *bool                           `json:"duplicate,omitempty"`  // 중복 의심 여부로 필터링
+	UserID     *string                         `json:"userId,omitempty"`     // 해당 사용자가 보낸 요청만 조회
+}
+
+// RestaurantDeletedFilter는 매장 목록 조회 시 삭제된 매장 포함 여부입니다.
+type RestaurantDeletedFilter string
+
+const (
+	DELETED_EXCLUDE RestaurantDeletedFilter = "exclude" // 삭제되지 않은 매장만 (기본값)
+	DELETED_INCLUDE RestaurantDeletedFilter = "include" // 삭제 여부와 관계없이
+	DELETED_ONLY    RestaurantDeletedFilter = "only"    // 삭제된 매장만
+)
+
+// RestaurantQuery는 매장 목록 조회를 위한 쿼리 파라미터 DTO입니다.
+type RestaurantQuery struct {
+	Page     int                      `json:"page"`
+	PageSize int                      `json:"pageSize"`
+	Search   *string                  `json:"search,omitempty"` // 매장명, 주소, 전화번호 검색어
+	Status   *models.RestaurantStatus `json:"status,omitempty"`
+	OwnerID  *string                  `json:"ownerId,omitempty"`
+	Deleted  RestaurantDeletedFilter  `json:"deleted,omitempty"`
+}
+
+// TagSort는 태그 목록 정렬 기준입니다.
+type TagSort string
+
+const (
+	TAG_SORT_NAME  TagSort = "name"  // 이름 순 (기본값)
+	TAG_SORT_USAGE TagSort = "usage" // 사용 매장 수 많은 순
+)
+
+// TagQuery는 태그 목록 조회를 위한 쿼리 파라미터 DTO입니다.
+type TagQuery struct {
+	Page     int     `json:"page"`
+	PageSize int     `json:"pageSize"`
+	Search   *string `json:"search,omitempty"` // 태그 이름 검색어 (대소문자, 공백 무시)
+	Sort     TagSort `json:"sort,omitempty"`
+}
+
+// RestaurantRequestStatsQuery는 매장 요청 통계 조회를 위한 쿼리 파라미터 DTO입니다.
+type RestaurantRequestStatsQuery struct {
+	From      time.Time     `json:"from"`
+	To        time.Time     `json:"to"`
+	SLA       time.Duration `json:"sla"`
+	BreachMax int           `json:"breachMax"` // 반환할 SLA 초과 요청 최대 수
+}
+
+// OrderQuery는 주문 목록 조회를 위한 쿼리 파라미터 DTO입니다.
+type OrderQuery struct {
+	Page         int                 `json:"page"`
+	PageSize     int                 `json:"pageSize"`
+	RestaurantID *string             `json:"restaurantId,omitempty"`
+	CustomerID   *string             `json:"customerId,omitempty"`
+	Status       *models.OrderStatus `json:"status,omitempty"`
+	From         *time.Time          `json:"from,omitempty"` // 주문 시각 시작 (포함)
+	To           *time.Time          `json:"to,omitempty"`   // 주문 시각 끝 (미포함)
+}
+
+// UserQuery는 사용자 목록 조회를 위한 쿼리 파라미터 DTO입니다.
+type UserQuery struct {
+	Page      int          `json:"page"`
+	PageSize  int          `json:"pageSize"`
+	Search    *string      `json:"search,omitempty"` // 사용자 ID(일치), 이메일/이름(부분 일치) 검색어
+	Role      *models.Role `json:"role,omitempty"`
+	Suspended *bool        `json:"suspended,omitempty"`
+}
+
+// DeliveryQuery는 배달 중인 주문 배달 조회를 위한 쿼리 파라미터 DTO입니다.
+type DeliveryQuery struct {
+	Page         int                  `json:"page"`
+	PageSize     int                  `json:"pageSize"`
+	RestaurantID *string              `json:"restaurantId,omitempty"`
+	Type         *models.DeliveryType `json:"type,omitempty"`
+	Overdue      bool                 `json:"overdue,omitempty"` // 예상 도착 시각을 넘긴 배달만 조회
 }
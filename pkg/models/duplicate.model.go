@@ -0,0 +1,21 @@
+package models
+
+// DuplicateField는 중복 후보로 판단한 기준을 나타내는 열거형입니다.
+type DuplicateField string
+
+const (
+	DUPLICATE_LICENSE_NUMBER DuplicateField = "LICENSE_NUMBER" // 사업자등록번호 일치
+	DUPLICATE_PHONE_NUMBER   DuplicateField = "PHONE_NUMBER"   // 정규화한 전화번호 일치
+	DUPLICATE_ADDRESS        DuplicateField = "ADDRESS"        // 정규화한 주소 일치
+	DUPLICATE_NAME           DuplicateField = "NAME"           // 매장명 유사
+)
+
+// DuplicateWarning은 매장 요청과 중복으로 의심되는 매장 또는 다른 매장 요청입니다.
+type DuplicateWarning struct {
+	Field      DuplicateField  `json:"field"`
+	TargetType AuditTargetType `json:"targetType"` // RESTAURANT 또는 RESTAURANT_REQUEST
+	TargetID   string          `json:"targetId"`
+	Name       string          `json:"name"`                 // 대상 매장명
+	Status     string          `json:"status"`               // 대상 매장 또는 요청의 상태
+	Similarity *float64        `json:"similarity,omitempty"` // 매장명 유사도 (0~1, NAME인 경우)
+}
@@ -0,0 +1,130 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DomainEventType은 다른 서비스에 알리는 도메인 이벤트 종류입니다.
+type DomainEventType string
+
+const (
+	EVENT_REQUEST_APPROVED          DomainEventType = "RestaurantRequestApproved"
+	EVENT_REQUEST_REJECTED          DomainEventType = "RestaurantRequestRejected"
+	EVENT_RESTAURANT_STATUS_CHANGED DomainEventType = "RestaurantStatusChanged"
+	EVENT_ORDER_CANCELLED           DomainEventType = "OrderCancelled"
+	EVENT_USER_SUSPENDED            DomainEventType = "UserSuspended"
+	EVENT_USER_UNSUSPENDED          DomainEventType = "UserUnsuspended"
+	EVENT_USER_ROLE_CHANGED         DomainEventType = "UserRoleChanged"
+)
+
+// DomainEventStatus는 아웃박스 이벤트의 발행 상태입니다.
+type DomainEventStatus string
+
+const (
+	EVENT_PENDING   DomainEventStatus = "PENDING"   // 발행 대기 (재시도 포함)
+	EVENT_PUBLISHED DomainEventStatus = "PUBLISHED" // 발행 완료
+	EVENT_FAILED    DomainEventStatus = "FAILED"    // 최대 시도 횟수 초과
+)
+
+// DomainEvent는 외부로 발행되는 도메인 이벤트입니다.
+// ID는 멱등성 키로, 재발행되더라도 같은 값이므로 구독자는 이 값으로 중복을 제거해야 합니다.
+type DomainEvent struct {
+	ID            string          `json:"id"`
+	Type          DomainEventType `json:"type"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	ActorID       string          `json:"actorId,omitempty"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// DomainEventOutbox는 아웃박스에 저장된 이벤트와 발행 상태입니다.
+type DomainEventOutbox struct {
+	Seq           int64             `json:"seq" db:"id"`
+	Event         DomainEvent       `json:"event"`
+	Status        DomainEventStatus `json:"status" db:"status"`
+	Attempts      int               `json:"attempts" db:"attempts"`
+	LastError     *string           `json:"lastError,omitempty" db:"lastError"`
+	NextAttemptAt time.Time         `json:"nextAttemptAt" db:"nextAttemptAt"`
+	PublishedAt   *time.Time        `json:"publishedAt,omitempty" db:"publishedAt"`
+}
+
+// RestaurantRequestDecidedData는 RestaurantRequestApproved/RestaurantRequestRejected 이벤트 데이터입니다.
+type RestaurantRequestDecidedData struct {
+	RequestID         int                     `json:"requestId"`
+	RestaurantID      string                  `json:"restaurantId"`
+	UserID            string                  `json:"userId"`
+	Type              RestaurantRequestType   `json:"type"`
+	Status            RestaurantRequestStatus `json:"status"`
+	RejectReasonCodes []string                `json:"rejectReasonCodes,omitempty"`
+	RejectReason      *string                 `json:"rejectReason,omitempty"`
+	ProcessedBy       *string                 `json:"processedBy,omitempty"`
+	ProcessedAt       *time.Time              `json:"processedAt,omitempty"`
+}
+
+// RestaurantStatusChangedData는 RestaurantStatusChanged 이벤트 데이터입니다.
+type RestaurantStatusChangedData struct {
+	RestaurantID string           `json:"restaurantId"`
+	From         RestaurantStatus `json:"from"`
+	To           RestaurantStatus `json:"to"`
+	Action       RestaurantAction `json:"action"`
+	RequestID    *int             `json:"requestId,omitempty"` // 요청 처리에 따른 변경이면 해당 요청 ID
+}
+
+// OrderCancelledData는 OrderCancelled 이벤트 데이터입니다.
+type OrderCancelledData struct {
+	OrderID      string      `json:"orderId"`
+	RestaurantID string      `json:"restaurantId"`
+	CustomerID   string      `json:"customerId"`
+	From         OrderStatus `json:"from"`
+	Reason       string      `json:"reason"`
+	CancelledBy  string      `json:"cancelledBy"`
+	CancelledAt  time.Time   `json:"cancelledAt"`
+}
+
+// UserSuspensionChangedData는 UserSuspended/UserUnsuspended 이벤트 데이터입니다.
+type UserSuspensionChangedData struct {
+	UserID          string    `json:"userId"`
+	Suspended       bool      `json:"suspended"`
+	Reason          string    `json:"reason"`
+	ChangedBy       string    `json:"changedBy"`
+	ChangedAt       time.Time `json:"changedAt"`
+	RevokedSessions int64     `json:"revokedSessions"`
+}
+
+// UserRoleChangedData는 UserRoleChanged 이벤트 데이터입니다.
+type UserRoleChangedData struct {
+	UserID          string `json:"userId"`
+	From            Role   `json:"from"`
+	To              Role   `json:"to"`
+	Reason          string `json:"reason"`
+	ChangedBy       string `json:"changedBy"`
+	RevokedSessions int64  `json:"revokedSessions"`
+}
+
+// NewDomainEvent는 데이터를 직렬화하여 도메인 이벤트를 생성합니다.
+func NewDomainEvent(id string, eventType DomainEventType, aggregateType AuditTargetType, aggregateID string, actorID string, data interface{}) (*DomainEvent, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DomainEvent{
+		ID:            id,
+		Type:          eventType,
+		AggregateType: string(aggregateType),
+		AggregateID:   aggregateID,
+		ActorID:       actorID,
+		OccurredAt:    time.Now().UTC(),
+		Data:          raw,
+	}, nil
+}
+
+// EventPublishResult는 이벤트 릴레이 1회 실행 결과입니다.
+type EventPublishResult struct {
+	Leased    int `json:"leased"`
+	Published int `json:"published"`
+	Retried   int `json:"retried"`
+	Failed    int `json:"failed"`
+}
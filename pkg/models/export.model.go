@@ -0,0 +1,151 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportFormat은 목록 내보내기 파일 형식입니다.
+type ExportFormat string
+
+const (
+	EXPORT_CSV  ExportFormat = "csv"
+	EXPORT_XLSX ExportFormat = "xlsx"
+)
+
+// ContentType은 파일 형식의 MIME 타입을 반환합니다.
+func (f ExportFormat) ContentType() string {
+	if f == EXPORT_XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// ExportColumn은 내보내기 파일의 열 정의입니다. Key는 columns 파라미터로 선택할 때 쓰는 이름입니다.
+type ExportColumn[T any] struct {
+	Key    string
+	Header string // 한글 열 제목
+	Value  func(*T) string
+}
+
+// SelectExportColumns는 keys 순서대로 열을 선택합니다. keys가 비어 있으면 모든 열을 반환합니다.
+func SelectExportColumns[T any](columns []ExportColumn[T], keys []string) ([]ExportColumn[T], error) {
+	if len(keys) == 0 {
+		return columns, nil
+	}
+
+	byKey := make(map[string]ExportColumn[T], len(columns))
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		byKey[column.Key] = column
+		names = append(names, column.Key)
+	}
+
+	selected := make([]ExportColumn[T], 0, len(keys))
+	for _, key := range keys {
+		column, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("알 수 없는 열입니다: %s (사용 가능: %s)", key, strings.Join(names, ", "))
+		}
+		selected = append(selected, column)
+	}
+	return selected, nil
+}
+
+// exportTime은 시각을 Asia/Seoul 기준 "YYYY-MM-DD HH:MM:SS"로 변환합니다.
+func exportTime(t time.Time) string {
+	return t.In(SeoulLocation).Format("2006-01-02 15:04:05")
+}
+
+func exportTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return exportTime(*t)
+}
+
+func exportString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// RestaurantRequestExportColumns는 매장 요청 내보내기 열입니다.
+var RestaurantRequestExportColumns = []ExportColumn[RestaurantRequest]{
+	{"id", "요청 ID", func(r *RestaurantRequest) string { return strconv.Itoa(r.ID) }},
+	{"restaurantId", "매장 ID", func(r *RestaurantRequest) string { return r.RestaurantID }},
+	{"userId", "요청자 ID", func(r *RestaurantRequest) string { return r.UserID }},
+	{"type", "요청 유형", func(r *RestaurantRequest) string { return string(r.Type) }},
+	{"status", "상태", func(r *RestaurantRequest) string { return string(r.Status) }},
+	{"businessLicenseNumber", "사업자등록번호", func(r *RestaurantRequest) string { return exportString(r.BusinessLicenseNumber) }},
+	{"assigneeId", "점유 검토자 ID", func(r *RestaurantRequest) string { return exportString(r.AssigneeID) }},
+	{"processedBy", "처리자 ID", func(r *RestaurantRequest) string { return exportString(r.ProcessedBy) }},
+	{"processedAt", "처리 시각", func(r *RestaurantRequest) string { return exportTimePtr(r.ProcessedAt) }},
+	{"rejectReasonCodes", "거절 사유 코드", func(r *RestaurantRequest) string { return strings.Join(r.RejectReasonCodes, ", ") }},
+	{"rejectReason", "거절 사유", func(r *RestaurantRequest) string { return exportString(r.RejectReason) }},
+	{"createdAt", "요청 시각", func(r *RestaurantRequest) string { return exportTime(r.CreatedAt) }},
+	{"updatedAt", "수정 시각", func(r *RestaurantRequest) string { return exportTime(r.UpdatedAt) }},
+}
+
+// RestaurantExportColumns는 매장 내보내기 열입니다.
+var RestaurantExportColumns = []ExportColumn[Restaurant]{
+	{"id", "매장 ID", func(r *Restaurant) string { return r.ID }},
+	{"name", "매장명", func(r *Restaurant) string { return r.Name }},
+	{"status", "상태", func(r *Restaurant) string { return string(r.Status) }},
+	{"ownerId", "점주 ID", func(r *Restaurant) string { return r.OwnerID }},
+	{"address", "주소", func(r *Restaurant) string { return r.Address }},
+	{"phoneNumber", "전화번호", func(r *Restaurant) string { return r.PhoneNumber }},
+	{"deliveryAvailable", "배달 가능", func(r *Restaurant) string { return exportBool(r.DeliveryAvailable) }},
+	{"parkingAvailable", "주차 가능", func(r *Restaurant) string { return exportBool(r.ParkingAvailable) }},
+	{"createdAt", "등록 시각", func(r *Restaurant) string { return exportTime(r.CreatedAt) }},
+	{"updatedAt", "수정 시각", func(r *Restaurant) string { return exportTime(r.UpdatedAt) }},
+	{"deletedAt", "삭제 시각", func(r *Restaurant) string { return exportTimePtr(r.DeletedAt) }},
+}
+
+// OrderExportColumns는 주문 내보내기 열입니다.
+var OrderExportColumns = []ExportColumn[Order]{
+	{"id", "주문 ID", func(o *Order) string { return o.ID }},
+	{"restaurantId", "매장 ID", func(o *Order) string { return o.RestaurantID }},
+	{"customerId", "고객 ID", func(o *Order) string { return o.CustomerID }},
+	{"status", "상태", func(o *Order) string { return string(o.Status) }},
+	{"totalPrice", "합계 금액(현재 가격 기준)", func(o *Order) string { return strconv.Itoa(o.TotalPrice) }},
+	{"cancelReason", "취소 사유", func(o *Order) string { return exportString(o.CancelReason) }},
+	{"cancelledBy", "취소 처리자 ID", func(o *Order) string { return exportString(o.CancelledBy) }},
+	{"cancelledAt", "취소 시각", func(o *Order) string { return exportTimePtr(o.CancelledAt) }},
+	{"createdAt", "주문 시각", func(o *Order) string { return exportTime(o.CreatedAt) }},
+	{"updatedAt", "수정 시각", func(o *Order) string { return exportTime(o.UpdatedAt) }},
+}
+
+func exportBool(value bool) string {
+	if value {
+		return "Y"
+	}
+	return "N"
+}
+
+// ExportFile은 생성한 내보내기 파일입니다.
+type ExportFile struct {
+	FileName    string
+	ContentType string
+	Rows        int
+	Body        []byte
+}
+
+// ExportDownloadResponse는 S3에 저장한 내보내기 파일의 다운로드 정보입니다.
+type ExportDownloadResponse struct {
+	URL       string       `json:"url"`
+	Key       string       `json:"key"`
+	FileName  string       `json:"fileName"`
+	Format    ExportFormat `json:"format"`
+	Rows      int          `json:"rows"`
+	ExpiresAt int64        `json:"expiresAt"`
+}
+
+// ExportResult는 내보내기 결과입니다. 작은 CSV는 File로 바로 응답하고, 그 외에는 Download로 S3 다운로드 URL을 응답합니다.
+type ExportResult struct {
+	File     *ExportFile
+	Download *ExportDownloadResponse
+}
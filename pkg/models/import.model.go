@@ -0,0 +1,128 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RestaurantImportColumn은 매장 일괄 등록 CSV의 열 정의입니다. 머리글은 Key 또는 한글 Header 중 하나를 쓰면 됩니다.
+type RestaurantImportColumn struct {
+	Key      string
+	Header   string
+	Required bool
+}
+
+// RestaurantImportColumns는 매장 일괄 등록 CSV 열 목록입니다.
+var RestaurantImportColumns = []RestaurantImportColumn{
+	{"name", "매장명", true},
+	{"ownerId", "점주 ID", true},
+	{"address", "주소", true},
+	{"phoneNumber", "전화번호", true},
+	{"licenseNumber", "사업자등록번호", true},
+	{"businessName", "상호", false},
+	{"licenseImageUrl", "사업자등록증 이미지 URL", false},
+	{"hours", "영업 시간", false},
+	{"status", "상태", false},
+	{"description", "설명", false},
+	{"addressDescription", "주소 설명", false},
+	{"holiday", "휴무 안내", false},
+	{"parkingAvailable", "주차 가능", false},
+	{"parkingDescription", "주차 안내", false},
+	{"deliveryAvailable", "배달 가능", false},
+}
+
+// ParseBusinessHours는 "MON 0900-2200; TUE,WED 1100-2100; SAT-SUN 1000-2000" 형식의 영업 시간을 변환합니다.
+// 요일은 쉼표로 나열하거나 하이픈으로 범위를 지정할 수 있으며, 겹침 검사는 BusinessSchedule.Validate로 합니다.
+func ParseBusinessHours(value string) ([]BusinessHour, error) {
+	hours := []BusinessHour{}
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.Fields(entry)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("영업 시간 %q는 \"요일 HHmm-HHmm\" 형식이어야 합니다", entry)
+		}
+
+		times := strings.Split(fields[1], "-")
+		if len(times) != 2 {
+			return nil, fmt.Errorf("영업 시간 %q는 \"요일 HHmm-HHmm\" 형식이어야 합니다", entry)
+		}
+
+		days, err := parseImportDays(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, day := range days {
+			hours = append(hours, BusinessHour{DayOfWeek: day, OpenTime: times[0], CloseTime: times[1]})
+		}
+	}
+	return hours, nil
+}
+
+// parseImportDays는 "MON", "TUE,WED", "MON-FRI" 형식의 요일 목록을 변환합니다. 범위는 일요일에서 월요일로 넘어갈 수 있습니다.
+func parseImportDays(value string) ([]DayOfWeek, error) {
+	days := []DayOfWeek{}
+	for _, part := range strings.Split(strings.ToUpper(value), ",") {
+		bounds := strings.Split(part, "-")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("요일 %q가 올바르지 않습니다", part)
+		}
+
+		start, end := dayIndex(DayOfWeek(bounds[0])), dayIndex(DayOfWeek(bounds[len(bounds)-1]))
+		if start < 0 || end < 0 {
+			return nil, fmt.Errorf("알 수 없는 요일입니다: %s (MON~SUN)", part)
+		}
+		for i := start; ; i = (i + 1) % len(daysOfWeek) {
+			days = append(days, daysOfWeek[i])
+			if i == end {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// ImportRowError는 가져오기 행 단위 오류입니다. Row는 머리글을 1행으로 센 스프레드시트 행 번호입니다.
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportedRestaurant는 가져오기로 등록했거나(dryRun이면 등록할) 매장 요약입니다.
+type ImportedRestaurant struct {
+	Row           int              `json:"row"`
+	RestaurantID  string           `json:"restaurantId,omitempty"` // dryRun이면 비어 있음
+	Name          string           `json:"name"`
+	OwnerID       string           `json:"ownerId"`
+	LicenseNumber string           `json:"licenseNumber"`
+	Status        RestaurantStatus `json:"status"`
+	BusinessHours int              `json:"businessHours"` // 등록할 주간 영업 시간 수
+}
+
+// RestaurantImportReport는 매장 일괄 등록 결과입니다.
+// 오류가 하나라도 있으면 아무것도 등록하지 않으며, Applied는 실제로 저장했을 때만 true입니다.
+type RestaurantImportReport struct {
+	Key         string                  `json:"key"`
+	DryRun      bool                    `json:"dryRun"`
+	Applied     bool                    `json:"applied"`
+	TotalRows   int                     `json:"totalRows"`
+	ValidRows   int                     `json:"validRows"`
+	Errors      []ImportRowError        `json:"errors"`
+	Restaurants []ImportedRestaurant    `json:"restaurants"`
+	ErrorReport *ExportDownloadResponse `json:"errorReport,omitempty"` // 행 오류 CSV 다운로드 정보
+}
+
+// RestaurantImportUploadResponse는 가져올 CSV 업로드용 presigned PUT URL 정보입니다.
+type RestaurantImportUploadResponse struct {
+	Key         string `json:"key"` // 가져오기 요청에 그대로 전달할 S3 키
+	URL         string `json:"url"`
+	Method      string `json:"method"`
+	ContentType string `json:"contentType"` // 업로드 시 Content-Type 헤더에 그대로 사용해야 함
+	MaxFileSize int64  `json:"maxFileSize"`
+	ExpiresAt   int64  `json:"expiresAt"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// LicenseStatus는 사업자등록번호 상태 조회 결과를 나타내는 열거형입니다.
+type LicenseStatus string
+
+const (
+	LICENSE_ACTIVE    LicenseStatus = "ACTIVE"    // 계속사업자
+	LICENSE_SUSPENDED LicenseStatus = "SUSPENDED" // 휴업자
+	LICENSE_CLOSED    LicenseStatus = "CLOSED"    // 폐업자
+	LICENSE_UNKNOWN   LicenseStatus = "UNKNOWN"   // 미등록 번호이거나 조회 실패
+	LICENSE_INVALID   LicenseStatus = "INVALID"   // 형식 또는 검증번호 오류 (조회하지 않음)
+)
+
+// BusinessLicenseVerification은 사업자등록번호 상태 조회 결과 모델입니다.
+type BusinessLicenseVerification struct {
+	LicenseNumber string        `json:"licenseNumber" db:"licenseNumber"` // 하이픈 없는 10자리
+	Status        LicenseStatus `json:"status" db:"status"`
+	Provider      string        `json:"provider" db:"provider"`           // 조회 제공자 (nts, fake, checksum)
+	TaxType       *string       `json:"taxType,omitempty" db:"taxType"`   // 과세 유형
+	ClosedAt      *time.Time    `json:"closedAt,omitempty" db:"closedAt"` // 폐업일
+	Message       *string       `json:"message,omitempty" db:"message"`   // 제공자 응답 메시지 또는 실패 사유
+	CheckedAt     time.Time     `json:"checkedAt" db:"checkedAt"`         // 조회 시간
+}
+
+// Passed는 승인 가능한 상태(계속사업자)인지 확인합니다.
+func (v *BusinessLicenseVerification) Passed() bool {
+	return v != nil && v.Status == LICENSE_ACTIVE
+}
+
+// IsFresh는 조회 결과가 ttl 이내에 조회된 것인지 확인합니다.
+// 조회 실패(UNKNOWN)는 다시 조회할 수 있도록 항상 오래된 것으로 봅니다.
+func (v *BusinessLicenseVerification) IsFresh(now time.Time, ttl time.Duration) bool {
+	return v != nil && v.Status != LICENSE_UNKNOWN && now.Sub(v.CheckedAt) < ttl
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// NotificationChannel은 알림 발송 채널을 나타내는 열거형입니다.
+type NotificationChannel string
+
+const (
+	CHANNEL_EMAIL    NotificationChannel = "EMAIL"
+	CHANNEL_SMS      NotificationChannel = "SMS"
+	CHANNEL_WEB_PUSH NotificationChannel = "WEB_PUSH"
+	CHANNEL_LOG      NotificationChannel = "LOG" // 로컬/개발 환경용 로그 출력
+)
+
+// ParseNotificationChannels는 설정 값 목록을 알림 채널 목록으로 변환합니다. 알 수 없는 값과 중복은 무시합니다.
+func ParseNotificationChannels(values []string) []NotificationChannel {
+	channels := make([]NotificationChannel, 0, len(values))
+	seen := make(map[NotificationChannel]bool, len(values))
+	for _, value := range values {
+		channel := NotificationChannel(strings.ToUpper(strings.TrimSpace(value)))
+		switch channel {
+		case CHANNEL_EMAIL, CHANNEL_SMS, CHANNEL_WEB_PUSH, CHANNEL_LOG:
+			if !seen[channel] {
+				seen[channel] = true
+				channels = append(channels, channel)
+			}
+		}
+	}
+	return channels
+}
+
+// NotificationTemplate은 알림 메시지 템플릿 종류를 나타내는 열거형입니다.
+type NotificationTemplate string
+
+const (
+	TEMPLATE_REQUEST_APPROVED NotificationTemplate = "RESTAURANT_REQUEST_APPROVED"
+	TEMPLATE_REQUEST_REJECTED NotificationTemplate = "RESTAURANT_REQUEST_REJECTED"
+)
+
+// NotificationStatus는 아웃박스 알림의 발송 상태를 나타내는 열거형입니다.
+type NotificationStatus string
+
+const (
+	NOTIFICATION_PENDING NotificationStatus = "PENDING" // 발송 대기 (재시도 포함)
+	NOTIFICATION_SENT    NotificationStatus = "SENT"    // 발송 완료
+	NOTIFICATION_FAILED  NotificationStatus = "FAILED"  // 최대 시도 횟수 초과 또는 발송 불가
+	NOTIFICATION_SKIPPED NotificationStatus = "SKIPPED" // 수신 주소가 없어 발송하지 않음
+)
+
+// NotificationOutbox는 발송 대기 중인 알림 모델입니다.
+type NotificationOutbox struct {
+	ID            int64                `json:"id" db:"id"`
+	UserID        string               `json:"userId" db:"userId"`
+	Channel       NotificationChannel  `json:"channel" db:"channel"`
+	Template      NotificationTemplate `json:"template" db:"template"`
+	Locale        Locale               `json:"locale" db:"locale"`
+	Payload       json.RawMessage      `json:"payload" db:"payload"`
+	Status        NotificationStatus   `json:"status" db:"status"`
+	Attempts      int                  `json:"attempts" db:"attempts"`
+	LastError     *string              `json:"lastError,omitempty" db:"lastError"`
+	NextAttemptAt time.Time            `json:"nextAttemptAt" db:"nextAttemptAt"`
+	CreatedAt     time.Time            `json:"createdAt" db:"createdAt"`
+	SentAt        *time.Time           `json:"sentAt,omitempty" db:"sentAt"`
+}
+
+// RestaurantRequestNotification은 매장 요청 처리 알림 페이로드입니다.
+type RestaurantRequestNotification struct {
+	RequestID         int                     `json:"requestId"`
+	RestaurantID      string                  `json:"restaurantId"`
+	Status            RestaurantRequestStatus `json:"status"`
+	RejectReasonCodes []string                `json:"rejectReasonCodes,omitempty"`
+	RejectNote        *string                 `json:"rejectNote,omitempty"`
+	RejectReason      *string                 `json:"rejectReason,omitempty"` // 처리 시점의 한국어 거절 사유
+}
+
+// NotificationRecipient는 알림 수신자 정보입니다.
+type NotificationRecipient struct {
+	UserID         string
+	Name           string
+	Email          string
+	PhoneNumber    string // 매장 대표 전화번호
+	RestaurantName string
+}
+
+// NotificationMessage는 채널로 발송할 렌더링된 메시지입니다.
+type NotificationMessage struct {
+	Subject string // 이메일 제목, 웹 푸시 제목
+	Body    string // 이메일 본문
+	Short   string // SMS, 웹 푸시용 짧은 본문
+}
+
+// NotificationDispatchResult는 알림 워커 1회 실행 결과입니다.
+type NotificationDispatchResult struct {
+	Leased  int `json:"leased"`
+	Sent    int `json:"sent"`
+	Retried int `json:"retried"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+}
@@ -37,32 +37,38 @@ type Order struct {
 	RestaurantID string         `json:"restaurantId" db:"restaurantId"`
 	CustomerID   string         `json:"customerId" db:"customerId"`
 	Status       OrderStatus    `json:"status" db:"status"`
+	CancelReason *string        `json:"cancelReason,omitempty" db:"cancelReason"` // 관리자 강제 취소 사유
+	CancelledBy  *string        `json:"cancelledBy,omitempty" db:"cancelledBy"`   // 강제 취소한 관리자 ID
+	CancelledAt  *time.Time     `json:"cancelledAt,omitempty" db:"cancelledAt"`
+	TotalPrice   int            `json:"totalPrice"` // 현재 메뉴 가격 × 수량 합계 (조회 시 계산한 추정치, 주문 시점 가격 아님)
 	CreatedAt    time.Time      `json:"createdAt" db:"createdAt"`
 	UpdatedAt    time.Time      `json:"updatedAt" db:"updatedAt"`
-	Restaurant   Restaurant     `json:"restaurant,omitempty"`
+	Restaurant   *Restaurant    `json:"restaurant,omitempty"`
 	Menus        []OrderMenu    `json:"menus,omitempty"`
-	Customer     User           `json:"customer,omitempty"`
+	Customer     *User          `json:"customer,omitempty"`
 	Delivery     *OrderDelivery `json:"delivery,omitempty"`
 }
 
 // OrderDelivery는 주문 배달 정보 모델입니다.
 type OrderDelivery struct {
-	ID            string         `json:"id" db:"id"`
-	OrderID       string         `json:"orderId" db:"orderId"`
-	Status        DeliveryStatus `json:"status" db:"status"`
-	Type          *DeliveryType  `json:"type,omitempty" db:"type"`
-	DeliveryInfo  interface{}    `json:"deliveryInfo,omitempty" db:"deliveryInfo"` // JSON 데이터
-	EstimatedTime *int           `json:"estimatedTime,omitempty" db:"estimatedTime"`
-	CreatedAt     time.Time      `json:"createdAt" db:"createdAt"`
-	UpdatedAt     time.Time      `json:"updatedAt" db:"updatedAt"`
-	Order         Order          `json:"order,omitempty"`
+	ID                string         `json:"id" db:"id"`
+	OrderID           string         `json:"orderId" db:"orderId"`
+	Status            DeliveryStatus `json:"status" db:"status"`
+	Type              *DeliveryType  `json:"type,omitempty" db:"type"`
+	DeliveryInfo      DeliveryInfo   `json:"deliveryInfo,omitempty" db:"deliveryInfo"` // 배달 유형별 배달 정보 (JSON 컬럼)
+	DeliveryInfoError *string        `json:"deliveryInfoError,omitempty"`              // 저장된 배달 정보가 유형에 맞지 않을 때 검증 에러
+	EstimatedTime     *int           `json:"estimatedTime,omitempty" db:"estimatedTime"`
+	CreatedAt         time.Time      `json:"createdAt" db:"createdAt"`
+	UpdatedAt         time.Time      `json:"updatedAt" db:"updatedAt"`
+	Order             *Order         `json:"order,omitempty"`
 }
 
 // OrderMenu는 주문 메뉴 모델입니다.
 type OrderMenu struct {
-	OrderID  string         `json:"orderId" db:"orderId"`
-	MenuID   string         `json:"menuId" db:"menuId"`
-	Quantity int            `json:"quantity" db:"quantity"`
-	Order    Order          `json:"order,omitempty"`
-	Menu     RestaurantMenu `json:"menu,omitempty"`
+	OrderID  string          `json:"orderId" db:"orderId"`
+	MenuID   string          `json:"menuId" db:"menuId"`
+	Quantity int             `json:"quantity" db:"quantity"`
+	Subtotal int             `json:"subtotal"` // 현재 메뉴 가격 × 수량 (조회 시 계산한 추정치)
+	Order    *Order          `json:"order,omitempty"`
+	Menu     *RestaurantMenu `json:"menu,omitempty"`
 }
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Locale은 메시지 언어를 나타내는 열거형입니다.
+type Locale string
+
+const (
+	LOCALE_KO Locale = "ko"
+	LOCALE_EN Locale = "en"
+)
+
+// RejectReasonCode는 관리되는 거절 사유 코드 모델입니다.
+type RejectReasonCode struct {
+	Code       string    `json:"code" db:"code"`
+	TitleKo    string    `json:"titleKo" db:"titleKo"`
+	TitleEn    string    `json:"titleEn" db:"titleEn"`
+	TemplateKo string    `json:"templateKo" db:"templateKo"` // 점주에게 보여줄 한국어 안내 문구
+	TemplateEn string    `json:"templateEn" db:"templateEn"` // 점주에게 보여줄 영어 안내 문구
+	Active     bool      `json:"active" db:"active"`
+	SortOrder  int       `json:"sortOrder" db:"sortOrder"`
+	CreatedAt  time.Time `json:"createdAt" db:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt" db:"updatedAt"`
+}
+
+// Template은 언어에 맞는 안내 문구를 반환합니다. 영어 문구가 없으면 한국어 문구를 사용합니다.
+func (c *RejectReasonCode) Template(locale Locale) string {
+	if locale == LOCALE_EN && c.TemplateEn != "" {
+		return c.TemplateEn
+	}
+	return c.TemplateKo
+}
+
+// RenderRejectReason은 거절 사유 코드와 비고를 점주에게 보여줄 문장으로 조합합니다.
+func RenderRejectReason(codes []RejectReasonCode, note *string, locale Locale) string {
+	lines := make([]string, 0, len(codes)+1)
+	for i := range codes {
+		lines = append(lines, "- "+codes[i].Template(locale))
+	}
+
+	if note != nil && strings.TrimSpace(*note) != "" {
+		label := "비고: "
+		if locale == LOCALE_EN {
+			label = "Note: "
+		}
+		lines = append(lines, label+strings.TrimSpace(*note))
+	}
+
+	return strings.Join(lines, "\n")
+}
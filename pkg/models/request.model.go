@@ -2,16 +2,187 @@ package models
 
 // PresignedURLRequest는 클라이언트로부터 받는 요청 구조체입니다.
 type PresignedURLRequest struct {
-	Bucket      string `json:"bucket"`      // S3 버킷 이름
-	Key         string `json:"key"`         // 파일 경로/이름
-	Method      string `json:"method"`      // HTTP 메서드 (GET/PUT)
-	Duration    int64  `json:"duration"`    // URL 유효 기간(초)
-	ContentType string `json:"contentType"` // 파일 콘텐츠 타입 (PUT 요청시 유효)
-	MaxFileSize int64  `json:"maxFileSize"` // 최대 파일 크기 (바이트 단위, PUT 요청시 유효)
+	Bucket      string        `json:"bucket"`      // S3 버킷 이름
+	Key         string        `json:"key"`         // 파일 경로/이름 (GET 요청시. 업로드 요청시 서버가 생성)
+	FileName    string        `json:"fileName"`    // 업로드할 파일 이름 (업로드 요청시 확장자 결정에 사용)
+	Purpose     UploadPurpose `json:"purpose"`     // 업로드 용도 (업로드 요청시 필수, 용도별 허용 파일 형식 적용)
+	Method      string        `json:"method"`      // HTTP 메서드 (GET/PUT/POST). POST는 크기와 타입을 S3가 강제합니다
+	Duration    int64         `json:"duration"`    // URL 유효 기간(초)
+	ContentType string        `json:"contentType"` // 파일 콘텐츠 타입 (업로드 요청시 유효)
+	MaxFileSize int64         `json:"maxFileSize"` // 최대 파일 크기 (바이트 단위, 업로드 요청시 유효. PUT은 참고용)
 }
 
 // ProcessRestaurantRequest는 매장 생성 요청 처리 페이로드입니다.
+// 거절 시 거절 사유 코드를 하나 이상 지정해야 하며, 비고는 선택입니다.
 type ProcessRestaurantRequest struct {
-	Status       RestaurantRequestStatus `json:"status" validate:"required,oneof=APPROVED REJECTED"`
-	RejectReason *string                 `json:"rejectReason,omitempty" validate:"required_if=Status REJECTED,omitempty"`
+	Status            RestaurantRequestStatus `json:"status" validate:"required,oneof=APPROVED REJECTED"`
+	RejectReasonCodes []string                `json:"rejectReasonCodes,omitempty" validate:"required_if=Status REJECTED,omitempty,min=1,max=10,dive,required"`
+	RejectNote        *string                 `json:"rejectNote,omitempty" validate:"omitempty,max=1000"`
+	Override          bool                    `json:"override,omitempty"` // 슈퍼 관리자가 다른 검토자의 점유를 무시하고 처리
+	// 사업자등록번호 검증에 실패한 요청을 승인하려면 사유와 함께 명시적으로 무시해야 합니다.
+	LicenseOverride       bool    `json:"licenseOverride,omitempty"`
+	LicenseOverrideReason *string `json:"licenseOverrideReason,omitempty" validate:"required_if=LicenseOverride true,omitempty,min=1,max=500"`
+}
+
+// ChangeRestaurantStatusRequest는 매장 상태 변경 페이로드입니다.
+type ChangeRestaurantStatusRequest struct {
+	Action RestaurantAction `json:"action" validate:"required,oneof=OPEN CLOSE HIDE"`
+	Reason string           `json:"reason" validate:"required,max=500"`
+}
+
+// UpdateRestaurantRequest는 매장 정보 수정 페이로드입니다. 생략한 필드는 유지됩니다.
+// 선택 항목(설명, 주소 설명, 이벤트 설명, 휴무일, 주차 설명)은 빈 문자열을 보내면 값을 비웁니다.
+type UpdateRestaurantRequest struct {
+	Name               *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Description        *string `json:"description,omitempty" validate:"omitempty,max=2000"`
+	Address            *string `json:"address,omitempty" validate:"omitempty,min=1,max=300"`
+	PhoneNumber        *string `json:"phoneNumber,omitempty" validate:"omitempty,phone"`
+	AddressDescription *string `json:"addressDescription,omitempty" validate:"omitempty,max=500"`
+	EventDescription   *string `json:"eventDescription,omitempty" validate:"omitempty,max=2000"`
+	Holiday            *string `json:"holiday,omitempty" validate:"omitempty,max=200"`
+	ParkingAvailable   *bool   `json:"parkingAvailable,omitempty"`
+	ParkingDescription *string `json:"parkingDescription,omitempty" validate:"omitempty,max=500"`
+	DeliveryAvailable  *bool   `json:"deliveryAvailable,omitempty"`
+	Reason             *string `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// DeleteRestaurantRequest는 매장 삭제/복구 페이로드입니다.
+type DeleteRestaurantRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// CancelOrderRequest는 관리자 주문 강제 취소 페이로드입니다.
+type CancelOrderRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// SuspendUserRequest는 사용자 계정 정지/정지 해제 페이로드입니다.
+type SuspendUserRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// ImportRestaurantsRequest는 업로드한 CSV로 매장을 일괄 등록하는 페이로드입니다.
+// dryRun이면 검증과 등록 예정 목록만 반환하고 아무것도 저장하지 않습니다.
+type ImportRestaurantsRequest struct {
+	Key    string `json:"key" validate:"required,max=1024"`
+	DryRun bool   `json:"dryRun"`
+}
+
+// ChangeUserRoleRequest는 사용자 역할 변경 페이로드입니다.
+type ChangeUserRoleRequest struct {
+	Role   Role   `json:"role" validate:"required,oneof=CUSTOMER OWNER ADMIN"`
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// CorrectDeliveryStatusRequest는 관리자 배달 상태 정정 페이로드입니다.
+type CorrectDeliveryStatusRequest struct {
+	Status DeliveryStatus `json:"status" validate:"required,oneof=NOT_CALLED DELIVERING COMPLETED"`
+	Reason string         `json:"reason" validate:"required,max=500"`
+}
+
+// ReplaceBusinessScheduleRequest는 매장 영업 일정 전체 교체 페이로드입니다.
+// 생략하거나 빈 배열로 보낸 항목은 모두 삭제됩니다.
+type ReplaceBusinessScheduleRequest struct {
+	Hours      []BusinessHourInput          `json:"hours" validate:"max=70,dive"`
+	Holidays   []RestaurantHolidayInput     `json:"holidays" validate:"max=35,dive"`
+	Exceptions []BusinessHourExceptionInput `json:"exceptions" validate:"max=366,dive"`
+	Reason     *string                      `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// BusinessHourInput은 주간 영업 시간 항목입니다. 종료 시간이 시작 시간보다 이르면 다음 날 종료로 봅니다.
+type BusinessHourInput struct {
+	DayOfWeek DayOfWeek `json:"dayOfWeek" validate:"required,oneof=MON TUE WED THU FRI SAT SUN"`
+	OpenTime  string    `json:"openTime" validate:"required,hhmm"`
+	CloseTime string    `json:"closeTime" validate:"required,hhmm"`
+}
+
+// RestaurantHolidayInput은 정기 휴무일 항목입니다.
+type RestaurantHolidayInput struct {
+	DayOfWeek   DayOfWeek `json:"dayOfWeek" validate:"required,oneof=MON TUE WED THU FRI SAT SUN"`
+	WeekOfMonth *int      `json:"weekOfMonth,omitempty" validate:"omitempty,min=1,max=5"`
+}
+
+// BusinessHourExceptionInput은 특정 날짜의 임시 휴무 또는 특별 영업 시간 항목입니다.
+type BusinessHourExceptionInput struct {
+	Date      string  `json:"date" validate:"required,datetime=2006-01-02"`
+	Closed    bool    `json:"closed"`
+	OpenTime  *string `json:"openTime,omitempty" validate:"required_if=Closed false,omitempty,hhmm"`
+	CloseTime *string `json:"closeTime,omitempty" validate:"required_if=Closed false,omitempty,hhmm"`
+	Note      *string `json:"note,omitempty" validate:"omitempty,max=200"`
+}
+
+// ModerateRestaurantMenuRequest는 메뉴 검수(수정/숨김) 페이로드입니다. 생략한 필드는 유지됩니다.
+// 설명은 빈 문자열을 보내면 값을 비웁니다. 검수 사유는 필수입니다.
+type ModerateRestaurantMenuRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Price       *int    `json:"price,omitempty" validate:"omitempty,min=0,max=10000000"` // 0원 이상 1천만 원 이하
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Hidden      *bool   `json:"hidden,omitempty"`
+	ImageHidden *bool   `json:"imageHidden,omitempty"`
+	Reason      string  `json:"reason" validate:"required,max=500"`
+}
+
+// HideRestaurantMenusRequest는 매장 메뉴 일괄 숨김 페이로드입니다.
+type HideRestaurantMenusRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// CreateTagRequest는 태그 생성 페이로드입니다.
+type CreateTagRequest struct {
+	Name        string  `json:"name" validate:"required,max=50"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=200"`
+}
+
+// UpdateTagRequest는 태그 수정 페이로드입니다. 생략한 필드는 유지되며, 설명은 빈 문자열을 보내면 값을 비웁니다.
+type UpdateTagRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,max=50"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=200"`
+}
+
+// MergeTagsRequest는 태그 병합 페이로드입니다. 원본 태그의 매장 연결을 대상 태그로 옮기고 원본 태그를 삭제합니다.
+type MergeTagsRequest struct {
+	SourceIDs []int `json:"sourceIds" validate:"required,min=1,max=50,dive,min=1"`
+}
+
+// AssignRestaurantTagsRequest는 매장 태그 지정 페이로드입니다. 이미 붙은 태그는 무시합니다.
+type AssignRestaurantTagsRequest struct {
+	TagIDs []int `json:"tagIds" validate:"required,min=1,max=50,dive,min=1"`
+}
+
+// BulkProcessRestaurantRequest는 매장 요청 일괄 처리 페이로드입니다.
+// 결정과 거절 사유는 단건 처리와 같은 검증 규칙을 따릅니다.
+type BulkProcessRestaurantRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1,max=200,dive,required"`
+	ProcessRestaurantRequest
+}
+
+// ClaimRestaurantRequest는 매장 요청 점유 페이로드입니다.
+type ClaimRestaurantRequest struct {
+	TTLMinutes int  `json:"ttlMinutes,omitempty" validate:"omitempty,min=1"` // 점유 유지 시간(분), 생략 시 기본값
+	Force      bool `json:"force,omitempty"`                                 // 슈퍼 관리자가 다른 검토자의 점유를 가져옴
+}
+
+// ReleaseRestaurantRequest는 매장 요청 점유 해제 페이로드입니다.
+type ReleaseRestaurantRequest struct {
+	Force bool `json:"force,omitempty"` // 슈퍼 관리자가 다른 검토자의 점유를 해제
+}
+
+// CreateRejectReasonCodeRequest는 거절 사유 코드 생성 페이로드입니다.
+type CreateRejectReasonCodeRequest struct {
+	Code       string `json:"code" validate:"required,max=50"`
+	TitleKo    string `json:"titleKo" validate:"required,max=100"`
+	TitleEn    string `json:"titleEn" validate:"omitempty,max=100"`
+	TemplateKo string `json:"templateKo" validate:"required,max=1000"`
+	TemplateEn string `json:"templateEn" validate:"omitempty,max=1000"`
+	SortOrder  int    `json:"sortOrder"`
+}
+
+// UpdateRejectReasonCodeRequest는 거절 사유 코드 수정 페이로드입니다. 생략한 필드는 유지됩니다.
+type UpdateRejectReasonCodeRequest struct {
+	TitleKo    *string `json:"titleKo,omitempty" validate:"omitempty,min=1,max=100"`
+	TitleEn    *string `json:"titleEn,omitempty" validate:"omitempty,max=100"`
+	TemplateKo *string `json:"templateKo,omitempty" validate:"omitempty,min=1,max=1000"`
+	TemplateEn *string `json:"templateEn,omitempty" validate:"omitempty,max=1000"`
+	Active     *bool   `json:"active,omitempty"`
+	SortOrder  *int    `json:"sortOrder,omitempty"`
 }
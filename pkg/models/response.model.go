@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type APIResponse struct {
 	Status string      `json:"status"`
 	Data   interface{} `json:"data"`
@@ -24,10 +26,224 @@ type RestaurantRequestsResponse struct {
 	Pagination `json:",inline"`
 }
 
+// RestaurantsResponse는 매장 목록 응답입니다.
+type RestaurantsResponse struct {
+	Restaurants []Restaurant `json:"restaurants"`
+	Pagination  `json:",inline"`
+}
+
+// HideRestaurantMenusResponse는 매장 메뉴 일괄 숨김 결과입니다.
+type HideRestaurantMenusResponse struct {
+	RestaurantID string `json:"restaurantId"`
+	HiddenCount  int    `json:"hiddenCount"` // 이번 요청으로 새로 숨긴 메뉴 수
+}
+
+// TagsResponse는 태그 목록 응답입니다.
+type TagsResponse struct {
+	Tags       []Tag `json:"tags"`
+	Pagination `json:",inline"`
+}
+
+// OrdersResponse는 주문 목록 응답입니다.
+type OrdersResponse struct {
+	Orders     []Order `json:"orders"`
+	Pagination `json:",inline"`
+}
+
+// UsersResponse는 사용자 목록 응답입니다.
+type UsersResponse struct {
+	Users      []User `json:"users"`
+	Pagination `json:",inline"`
+}
+
+// UserDetailResponse는 사용자 상세 응답입니다.
+// 매장, 주문, 매장 요청은 최근 항목만 포함하며 전체 개수는 각 Count 필드로 알려줍니다.
+type UserDetailResponse struct {
+	User            *User               `json:"user"`
+	ActiveSessions  int                 `json:"activeSessions"` // 관리자 세션 수
+	Restaurants     []Restaurant        `json:"restaurants"`
+	RestaurantCount int                 `json:"restaurantCount"`
+	RecentOrders    []Order             `json:"recentOrders"`
+	OrderCount      int                 `json:"orderCount"`
+	Requests        []RestaurantRequest `json:"requests"`
+	RequestCount    int                 `json:"requestCount"`
+}
+
+// InFlightDeliveriesResponse는 배달 중인 주문 배달 목록 응답입니다.
+type InFlightDeliveriesResponse struct {
+	Deliveries []InFlightDelivery `json:"deliveries"`
+	Pagination `json:",inline"`
+}
+
+// TagMergeResponse는 태그 병합 결과입니다.
+type TagMergeResponse struct {
+	Tag            *Tag  `json:"tag"`
+	MergedTagIDs   []int `json:"mergedTagIds"`
+	MovedCount     int   `json:"movedCount"`     // 대상 태그로 옮긴 매장 연결 수
+	DuplicateCount int   `json:"duplicateCount"` // 대상 태그가 이미 붙어 있어 삭제한 매장 연결 수
+}
+
 // PresignedURLResponse는 생성된 presigned URL을 포함한 응답 구조체입니다.
 type PresignedURLResponse struct {
-	URL         string `json:"url"`         // 생성된 presigned URL
-	ContentType string `json:"contentType"` // 콘텐츠 타입 (PUT 요청시만 반환)
-	MaxFileSize int64  `json:"maxFileSize"` // 최대 파일 크기 (PUT 요청시만 반환)
-	ExpiresAt   int64  `json:"expiresAt"`   // URL 만료 시간 (Unix 타임스탬프)
+	URL         string            `json:"url"`                // 생성된 presigned URL (POST는 폼을 전송할 버킷 URL)
+	Bucket      string            `json:"bucket"`             // 대상 버킷
+	Key         string            `json:"key"`                // 대상 키 (업로드 요청시 서버가 생성한 키)
+	Method      string            `json:"method"`             // URL에 사용할 HTTP 메서드
+	UploadID    string            `json:"uploadId,omitempty"` // 업로드 등록 ID (업로드 요청시만 반환, 업로드 후 확인 API에 사용)
+	Fields      map[string]string `json:"fields,omitempty"`   // POST 폼 필드 (POST 요청시만 반환, file 필드보다 앞에 보내야 함)
+	ContentType string            `json:"contentType"`        // 콘텐츠 타입 (업로드 요청시만 반환)
+	MaxFileSize int64             `json:"maxFileSize"`        // 최대 파일 크기 (업로드 요청시만 반환)
+	ExpiresAt   int64             `json:"expiresAt"`          // URL 만료 시간 (Unix 타임스탬프)
+}
+
+// BulkProcessResult는 일괄 처리 시 요청 ID별 처리 결과입니다.
+type BulkProcessResult string
+
+const (
+	BULK_SUCCEEDED         BulkProcessResult = "SUCCEEDED"
+	BULK_ALREADY_PROCESSED BulkProcessResult = "ALREADY_PROCESSED"
+	BULK_NOT_FOUND         BulkProcessResult = "NOT_FOUND"
+	BULK_ERROR             BulkProcessResult = "ERROR"
+	BULK_INVALID_ID        BulkProcessResult = "INVALID_ID"
+)
+
+// BulkProcessItemResult는 요청 하나의 일괄 처리 결과입니다.
+type BulkProcessItemResult struct {
+	ID      string             `json:"id"`
+	Result  BulkProcessResult  `json:"result"`
+	Message string             `json:"message,omitempty"`
+	Request *RestaurantRequest `json:"request,omitempty"`
+}
+
+// BulkProcessRestaurantResponse는 매장 요청 일괄 처리 응답입니다.
+type BulkProcessRestaurantResponse struct {
+	Results   []BulkProcessItemResult `json:"results"`
+	Succeeded int                     `json:"succeeded"`
+	Failed    int                     `json:"failed"`
+}
+
+// AuditLogsResponse는 커서 기반 감사 로그 목록 응답입니다.
+type AuditLogsResponse struct {
+	Logs       []AuditLog `json:"logs"`
+	NextCursor *string    `json:"nextCursor"` // 다음 페이지가 없으면 null
+}
+
+// QueueDepth는 상태/유형별 매장 요청 수입니다.
+type QueueDepth struct {
+	Status RestaurantRequestStatus `json:"status"`
+	Type   RestaurantRequestType   `json:"type"`
+	Count  int                     `json:"count"`
+}
+
+// DecisionTimeStats는 기간 내 처리된 요청의 처리 소요 시간 통계입니다 (시간 단위).
+type DecisionTimeStats struct {
+	Count       int      `json:"count"`
+	MedianHours *float64 `json:"medianHours"`
+	P90Hours    *float64 `json:"p90Hours"`
+}
+
+// SLABreach는 SLA를 초과하여 대기 중인 요청입니다.
+type SLABreach struct {
+	ID           int                   `json:"id"`
+	RestaurantID string                `json:"restaurantId"`
+	Type         RestaurantRequestType `json:"type"`
+	AssigneeID   *string               `json:"assigneeId,omitempty"`
+	CreatedAt    time.Time             `json:"createdAt"`
+	WaitingHours float64               `json:"waitingHours"`
+}
+
+// SLAStats는 SLA 초과 현황입니다.
+type SLAStats struct {
+	SLAHours        float64     `json:"slaHours"`
+	PendingBreaches int         `json:"pendingBreaches"` // 현재 SLA를 초과해 대기 중인 요청 수
+	DecidedBreaches int         `json:"decidedBreaches"` // 기간 내 SLA를 초과해 처리된 요청 수
+	OldestBreaches  []SLABreach `json:"oldestBreaches"`  // 가장 오래 대기 중인 SLA 초과 요청
+}
+
+// ReviewerStats는 검토자별 처리 통계입니다.
+type ReviewerStats struct {
+	ReviewerID     string  `json:"reviewerId"`
+	Approved       int     `json:"approved"`
+	Rejected       int     `json:"rejected"`
+	Total          int     `json:"total"`
+	ApprovalRatio  float64 `json:"approvalRatio"`
+	RejectionRatio float64 `json:"rejectionRatio"`
+}
+
+// RestaurantRequestStatsResponse는 매장 요청 큐 SLA/처리 통계 응답입니다.
+type RestaurantRequestStatsResponse struct {
+	From           time.Time         `json:"from"`
+	To             time.Time         `json:"to"`
+	QueueDepth     []QueueDepth      `json:"queueDepth"`
+	TimeToDecision DecisionTimeStats `json:"timeToDecision"`
+	SLA            SLAStats          `json:"sla"`
+	Reviewers      []ReviewerStats   `json:"reviewers"`
+}
+
+// SalesBucket은 구간별 주문 수와 GMV입니다.
+// GMV는 취소/거절되지 않은 주문의 메뉴 가격 × 수량 합계입니다.
+type SalesBucket struct {
+	Date            string `json:"date"` // 구간 시작 날짜 (YYYY-MM-DD, Asia/Seoul)
+	Orders          int    `json:"orders"`
+	CancelledOrders int    `json:"cancelledOrders"`
+	RejectedOrders  int    `json:"rejectedOrders"`
+	GMV             int64  `json:"gmv"`
+}
+
+// SalesAnalyticsResponse는 구간별 매출 집계 응답입니다.
+type SalesAnalyticsResponse struct {
+	From        time.Time         `json:"from"`
+	To          time.Time         `json:"to"`
+	Interval    AnalyticsInterval `json:"interval"`
+	Timezone    string            `json:"timezone"`
+	Buckets     []SalesBucket     `json:"buckets"`
+	TotalOrders int               `json:"totalOrders"`
+	TotalGMV    int64             `json:"totalGmv"`
+	GeneratedAt time.Time         `json:"generatedAt"`
+}
+
+// TopRestaurant는 기간 내 유효 주문(취소/거절 제외) 기준 매장 순위 항목입니다.
+type TopRestaurant struct {
+	RestaurantID string `json:"restaurantId"`
+	Name         string `json:"name"`
+	Orders       int    `json:"orders"`
+	GMV          int64  `json:"gmv"`
+}
+
+// TopMenu는 기간 내 유효 주문(취소/거절 제외) 기준 메뉴 순위 항목입니다.
+type TopMenu struct {
+	MenuID         string `json:"menuId"`
+	Name           string `json:"name"`
+	RestaurantID   string `json:"restaurantId"`
+	RestaurantName string `json:"restaurantName"`
+	Orders         int    `json:"orders"`
+	Quantity       int    `json:"quantity"`
+	GMV            int64  `json:"gmv"`
+}
+
+// RestaurantCancellation은 매장별 주문 취소/거절 비율입니다.
+type RestaurantCancellation struct {
+	RestaurantID     string  `json:"restaurantId"`
+	Name             string  `json:"name"`
+	Orders           int     `json:"orders"`
+	CancelledOrders  int     `json:"cancelledOrders"`
+	RejectedOrders   int     `json:"rejectedOrders"`
+	CancellationRate float64 `json:"cancellationRate"`
+	RejectionRate    float64 `json:"rejectionRate"`
+}
+
+// DeliveryTypeShare는 배달 유형별 주문 비중입니다. 배달 정보가 없는 주문은 NONE으로 집계합니다.
+type DeliveryTypeShare struct {
+	Type   string  `json:"type"`
+	Orders int     `json:"orders"`
+	Share  float64 `json:"share"`
+	GMV    int64   `json:"gmv"`
+}
+
+// AnalyticsRankingResponse는 순위/비율 집계 응답입니다.
+type AnalyticsRankingResponse[T any] struct {
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	Items       []T       `json:"items"`
+	GeneratedAt time.Time `json:"generatedAt"`
 }
@@ -21,9 +21,10 @@ const (
 type RestaurantRequestStatus string
 
 const (
-	PENDING  RestaurantRequestStatus = "PENDING"
-	APPROVED RestaurantRequestStatus = "APPROVED"
-	REJECTED RestaurantRequestStatus = "REJECTED"
+	PENDING   RestaurantRequestStatus = "PENDING"
+	APPROVED  RestaurantRequestStatus = "APPROVED"
+	REJECTED  RestaurantRequestStatus = "REJECTED"
+	WITHDRAWN RestaurantRequestStatus = "WITHDRAWN"
 )
 
 // RestaurantStatus는 매장 상태를 나타내는 열거형입니다.
@@ -49,16 +50,17 @@ type Tag struct {
 	ID          int             `json:"id" db:"id"`
 	Name        string          `json:"name" db:"name"`
 	Description *string         `json:"description,omitempty" db:"description"`
+	UsageCount  *int            `json:"usageCount,omitempty"` // 삭제되지 않은 매장 중 태그가 붙은 매장 수 (관리자 조회 시)
 	Restaurants []RestaurantTag `json:"restaurants,omitempty"`
 }
 
 // RestaurantTag는 매장과 태그의 다대다 관계를 나타내는 모델입니다.
 type RestaurantTag struct {
-	ID           int        `json:"id" db:"id"`
-	RestaurantID string     `json:"restaurantId" db:"restaurantId"`
-	TagID        int        `json:"tagId" db:"tagId"`
-	Restaurant   Restaurant `json:"restaurant,omitempty"`
-	Tag          Tag        `json:"tag,omitempty"`
+	ID           int         `json:"id" db:"id"`
+	RestaurantID string      `json:"restaurantId" db:"restaurantId"`
+	TagID        int         `json:"tagId" db:"tagId"`
+	Restaurant   *Restaurant `json:"restaurant,omitempty"`
+	Tag          *Tag        `json:"tag,omitempty"`
 }
 
 // Restaurant는 매장 모델입니다.
@@ -91,57 +93,87 @@ type Restaurant struct {
 
 // RestaurantBusiness 매장 사업자 정보 모델입니다.
 type RestaurantBusiness struct {
-	RestaurantID    string     `json:"restaurantId" db:"restaurantId"`
-	Name            string     `json:"name" db:"name"`
-	LicenseImageUrl string     `json:"licenseImageUrl" db:"licenseImageUrl"`
-	LicenseNumber   string     `json:"licenseNumber" db:"licenseNumber"`
-	Restaurant      Restaurant `json:"restaurant,omitempty"`
+	RestaurantID    string      `json:"restaurantId" db:"restaurantId"`
+	Name            string      `json:"name" db:"name"`
+	LicenseImageUrl string      `json:"licenseImageUrl" db:"licenseImageUrl"`
+	LicenseNumber   string      `json:"licenseNumber" db:"licenseNumber"`
+	Restaurant      *Restaurant `json:"restaurant,omitempty"`
 }
 
 // RestaurantImage는 매장 이미지 모델입니다.
 type RestaurantImage struct {
-	ID           string     `json:"id" db:"id"`
-	RestaurantID string     `json:"restaurantId" db:"restaurantId"`
-	ImageUrl     string     `json:"imageUrl" db:"imageUrl"`
-	Restaurant   Restaurant `json:"restaurant,omitempty"`
+	ID           string      `json:"id" db:"id"`
+	RestaurantID string      `json:"restaurantId" db:"restaurantId"`
+	ImageUrl     string      `json:"imageUrl" db:"imageUrl"`
+	Restaurant   *Restaurant `json:"restaurant,omitempty"`
 }
 
 // RestaurantMenu는 매장 메뉴 모델입니다.
 type RestaurantMenu struct {
-	ID           string      `json:"id" db:"id"`
-	RestaurantID string      `json:"restaurantId" db:"restaurantId"`
-	Name         string      `json:"name" db:"name"`
-	Price        int         `json:"price" db:"price"`
-	Description  *string     `json:"description,omitempty" db:"description"`
-	ImageURL     *string     `json:"imageUrl,omitempty" db:"imageUrl"`
-	Restaurant   Restaurant  `json:"restaurant,omitempty"`
-	OrderMenus   []OrderMenu `json:"orderMenus,omitempty"`
+	ID               string      `json:"id" db:"id"`
+	RestaurantID     string      `json:"restaurantId" db:"restaurantId"`
+	Name             string      `json:"name" db:"name"`
+	Price            int         `json:"price" db:"price"`
+	Description      *string     `json:"description,omitempty" db:"description"`
+	ImageURL         *string     `json:"imageUrl,omitempty" db:"imageUrl"`
+	Hidden           bool        `json:"hidden" db:"hidden"`                               // 관리자가 숨긴 메뉴
+	ImageHidden      bool        `json:"imageHidden" db:"imageHidden"`                     // 관리자가 숨긴 메뉴 이미지
+	ModerationReason *string     `json:"moderationReason,omitempty" db:"moderationReason"` // 마지막 검수 사유
+	ModeratedBy      *string     `json:"moderatedBy,omitempty" db:"moderatedBy"`           // 마지막 검수 관리자 ID
+	ModeratedAt      *time.Time  `json:"moderatedAt,omitempty" db:"moderatedAt"`
+	Restaurant       *Restaurant `json:"restaurant,omitempty"`
+	OrderMenus       []OrderMenu `json:"orderMenus,omitempty"`
 }
 
 // BusinessHour는 영업 시간 모델입니다.
 type BusinessHour struct {
-	ID           int        `json:"id" db:"id"`
-	RestaurantID string     `json:"restaurantId" db:"restaurantId"`
-	OpenTime     string     `json:"openTime" db:"openTime"`   // HHmm 형식
-	CloseTime    string     `json:"closeTime" db:"closeTime"` // HHmm 형식
-	DayOfWeek    DayOfWeek  `json:"dayOfWeek" db:"dayOfWeek"`
-	Restaurant   Restaurant `json:"restaurant,omitempty"`
+	ID           int         `json:"id" db:"id"`
+	RestaurantID string      `json:"restaurantId" db:"restaurantId"`
+	OpenTime     string      `json:"openTime" db:"openTime"`   // HHmm 형식
+	CloseTime    string      `json:"closeTime" db:"closeTime"` // HHmm 형식
+	DayOfWeek    DayOfWeek   `json:"dayOfWeek" db:"dayOfWeek"`
+	Restaurant   *Restaurant `json:"restaurant,omitempty"`
 }
 
 // RestaurantRequest는 매장 생성/수정 요청 모델입니다.
 type RestaurantRequest struct {
-	ID                      int                     `json:"id" db:"id"`
-	RestaurantID            string                  `json:"restaurantId" db:"restaurantId"`
-	UserID                  string                  `json:"userId" db:"userId"`
-	Name                    *string                 `json:"name,omitempty" db:"name"`
-	BusinessLicenseImageUrl *string                 `json:"businessLicenseImageUrl,omitempty" db:"businessLicenseImageUrl"`
-	BusinessLicenseNumber   *string                 `json:"businessLicenseNumber,omitempty" db:"businessLicenseNumber"`
-	RejectReason            *string                 `json:"rejectReason,omitempty" db:"rejectReason"`
-	Type                    RestaurantRequestType   `json:"type" db:"type"`
-	Status                  RestaurantRequestStatus `json:"status" db:"status"`
-	CreatedAt               time.Time               `json:"createdAt" db:"createdAt"`
-	UpdatedAt               time.Time               `json:"updatedAt" db:"updatedAt"`
-	DeletedAt               *time.Time              `json:"deletedAt,omitempty" db:"deletedAt"`
-	User                    *User                   `json:"user,omitempty"`
-	Restaurant              *Restaurant             `json:"restaurant,omitempty"`
+	ID                      int                          `json:"id" db:"id"`
+	RestaurantID            string                       `json:"restaurantId" db:"restaurantId"`
+	UserID                  string                       `json:"userId" db:"userId"`
+	Name                    *string                      `json:"name,omitempty" db:"name"`
+	BusinessLicenseImageUrl *string                      `json:"businessLicenseImageUrl,omitempty" db:"businessLicenseImageUrl"`
+	BusinessLicenseNumber   *string                      `json:"businessLicenseNumber,omitempty" db:"businessLicenseNumber"`
+	RejectReason            *string                      `json:"rejectReason,omitempty" db:"rejectReason"`
+	RejectReasonCodes       []string                     `json:"rejectReasonCodes,omitempty" db:"rejectReasonCodes"`
+	RejectNote              *string                      `json:"rejectNote,omitempty" db:"rejectNote"`
+	Type                    RestaurantRequestType        `json:"type" db:"type"`
+	Status                  RestaurantRequestStatus      `json:"status" db:"status"`
+	CreatedAt               time.Time                    `json:"createdAt" db:"createdAt"`
+	UpdatedAt               time.Time                    `json:"updatedAt" db:"updatedAt"`
+	DeletedAt               *time.Time                   `json:"deletedAt,omitempty" db:"deletedAt"`
+	Version                 int                          `json:"version" db:"version"`                         // 낙관적 동시성 제어용 버전
+	AssigneeID              *string                      `json:"assigneeId,omitempty" db:"assigneeId"`         // 점유 중인 검토자 ID
+	ClaimedAt               *time.Time                   `json:"claimedAt,omitempty" db:"claimedAt"`           // 점유 시작 시간
+	ClaimExpiresAt          *time.Time                   `json:"claimExpiresAt,omitempty" db:"claimExpiresAt"` // 점유 만료 시간
+	ProcessedBy             *string                      `json:"processedBy,omitempty" db:"processedBy"`       // 승인/거절한 검토자 ID
+	ProcessedAt             *time.Time                   `json:"processedAt,omitempty" db:"processedAt"`       // 승인/거절 시간
+	LicenseVerification     *BusinessLicenseVerification `json:"licenseVerification,omitempty"`                // 사업자등록번호 상태 조회 결과
+	DuplicateWarnings       []DuplicateWarning           `json:"duplicateWarnings,omitempty"`                  // 중복 의심 매장/요청
+	User                    *User                        `json:"user,omitempty"`
+	Restaurant              *Restaurant                  `json:"restaurant,omitempty"`
+}
+
+// ClearExpiredClaim은 만료된 점유 정보를 비웁니다.
+func (r *RestaurantRequest) ClearExpiredClaim(now time.Time) {
+	if r.ClaimExpiresAt != nil && !r.ClaimExpiresAt.After(now) {
+		r.AssigneeID = nil
+		r.ClaimedAt = nil
+		r.ClaimExpiresAt = nil
+	}
+}
+
+// IsClaimedByOther는 다른 검토자가 유효한 점유를 가지고 있는지 확인합니다.
+func (r *RestaurantRequest) IsClaimedByOther(userID string, now time.Time) bool {
+	return r.AssigneeID != nil && *r.AssigneeID != userID &&
+		r.ClaimExpiresAt != nil && r.ClaimExpiresAt.After(now)
 }
@@ -0,0 +1,290 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SeoulLocation은 영업 시간 계산에 사용하는 Asia/Seoul 시간대입니다.
+// 한국은 일광 절약 시간을 쓰지 않으므로 tzdata가 없는 런타임에서도 동작하도록 고정 오프셋을 사용합니다.
+var SeoulLocation = time.FixedZone("Asia/Seoul", 9*60*60)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+
+	// ScheduleDateLayout은 예외 날짜의 날짜 형식입니다.
+	ScheduleDateLayout = "2006-01-02"
+)
+
+// daysOfWeek는 월요일부터 시작하는 요일 순서입니다.
+var daysOfWeek = []DayOfWeek{MONDAY, TUESDAY, WEDNESDAY, THURSDAY, FRIDAY, SATURDAY, SUNDAY}
+
+// RestaurantHoliday는 매장의 정기 휴무일 모델입니다.
+type RestaurantHoliday struct {
+	ID           int       `json:"id" db:"id"`
+	RestaurantID string    `json:"restaurantId" db:"restaurantId"`
+	DayOfWeek    DayOfWeek `json:"dayOfWeek" db:"dayOfWeek"`
+	WeekOfMonth  *int      `json:"weekOfMonth,omitempty" db:"weekOfMonth"` // 1~5, 생략하면 매주
+}
+
+// BusinessHourException은 특정 날짜의 임시 휴무 또는 특별 영업 시간 모델입니다.
+type BusinessHourException struct {
+	ID           int     `json:"id" db:"id"`
+	RestaurantID string  `json:"restaurantId" db:"restaurantId"`
+	Date         string  `json:"date" db:"date"` // YYYY-MM-DD (Asia/Seoul)
+	Closed       bool    `json:"closed" db:"closed"`
+	OpenTime     *string `json:"openTime,omitempty" db:"openTime"`   // HHmm 형식
+	CloseTime    *string `json:"closeTime,omitempty" db:"closeTime"` // HHmm 형식
+	Note         *string `json:"note,omitempty" db:"note"`
+}
+
+// BusinessSchedule은 매장의 주간 영업 시간, 정기 휴무일, 예외 날짜를 묶은 영업 일정입니다.
+// 같은 날짜에는 예외 날짜, 정기 휴무일, 주간 영업 시간 순으로 적용됩니다.
+type BusinessSchedule struct {
+	RestaurantID string                  `json:"restaurantId"`
+	Timezone     string                  `json:"timezone"`
+	Hours        []BusinessHour          `json:"hours"`
+	Holidays     []RestaurantHoliday     `json:"holidays"`
+	Exceptions   []BusinessHourException `json:"exceptions"`
+	OpenStatus   *OpenStatus             `json:"openStatus,omitempty"`
+}
+
+// OpenStatus는 특정 시각의 영업 여부입니다.
+type OpenStatus struct {
+	At     time.Time `json:"at"`
+	IsOpen bool      `json:"isOpen"`
+}
+
+// timeRange는 하루 시작(00:00)부터의 분 단위 영업 구간입니다. 자정을 넘기면 end가 1440보다 큽니다.
+type timeRange struct {
+	start int
+	end   int
+}
+
+// ParseHHmm은 HHmm 형식의 시간을 자정부터의 분으로 변환합니다.
+// allowEndOfDay가 true면 하루의 끝을 뜻하는 2400을 허용합니다.
+func ParseHHmm(value string, allowEndOfDay bool) (int, error) {
+	if len(value) != 4 {
+		return 0, fmt.Errorf("시간 %q는 HHmm 형식이어야 합니다", value)
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("시간 %q는 HHmm 형식이어야 합니다", value)
+		}
+	}
+
+	hour := int(value[0]-'0')*10 + int(value[1]-'0')
+	minute := int(value[2]-'0')*10 + int(value[3]-'0')
+	if allowEndOfDay && hour == 24 && minute == 0 {
+		return minutesPerDay, nil
+	}
+	if hour > 23 || minute > 59 {
+		return 0, fmt.Errorf("시간 %q가 올바르지 않습니다 (0000~2359)", value)
+	}
+	return hour*60 + minute, nil
+}
+
+// parseTimeRange는 시작/종료 시간을 영업 구간으로 변환합니다.
+// 종료 시간이 시작 시간보다 이르면 다음 날 종료(예: 2200-0200)로 봅니다.
+func parseTimeRange(openTime, closeTime string) (timeRange, error) {
+	start, err := ParseHHmm(openTime, false)
+	if err != nil {
+		return timeRange{}, err
+	}
+	end, err := ParseHHmm(closeTime, true)
+	if err != nil {
+		return timeRange{}, err
+	}
+	if start == end {
+		return timeRange{}, fmt.Errorf("시작 시간과 종료 시간이 같을 수 없습니다 (%s-%s)", openTime, closeTime)
+	}
+	if end < start {
+		end += minutesPerDay
+	}
+	return timeRange{start: start, end: end}, nil
+}
+
+// DayOfWeekOf는 time.Weekday를 DayOfWeek로 변환합니다.
+func DayOfWeekOf(weekday time.Weekday) DayOfWeek {
+	return daysOfWeek[(int(weekday)+6)%7]
+}
+
+// dayIndex는 월요일을 0으로 하는 요일 순서를 반환합니다. 알 수 없는 요일은 -1입니다.
+func dayIndex(day DayOfWeek) int {
+	for i, d := range daysOfWeek {
+		if d == day {
+			return i
+		}
+	}
+	return -1
+}
+
+// Validate는 영업 일정을 검증합니다.
+// 주간 영업 시간은 HHmm 형식이어야 하고, 자정을 넘기는 구간을 포함해 한 주 안에서 서로 겹칠 수 없습니다.
+// 예외 날짜의 영업 시간도 전날 밤에서 넘어온 구간이나 다음 날 영업 구간과 겹칠 수 없습니다.
+func (s *BusinessSchedule) Validate() error {
+	type weeklyRange struct {
+		timeRange
+		label string
+	}
+
+	ranges := make([]weeklyRange, 0, len(s.Hours)+1)
+	for _, hour := range s.Hours {
+		day := dayIndex(hour.DayOfWeek)
+		if day < 0 {
+			return fmt.Errorf("알 수 없는 요일입니다: %s", hour.DayOfWeek)
+		}
+
+		r, err := parseTimeRange(hour.OpenTime, hour.CloseTime)
+		if err != nil {
+			return fmt.Errorf("%s 영업 시간 오류: %w", hour.DayOfWeek, err)
+		}
+
+		label := fmt.Sprintf("%s %s-%s", hour.DayOfWeek, hour.OpenTime, hour.CloseTime)
+		offset := day * minutesPerDay
+		ranges = append(ranges, weeklyRange{timeRange{offset + r.start, offset + r.end}, label})
+		// 일요일 밤에서 월요일로 넘어가는 구간은 주 시작 부분에도 둠
+		if offset+r.end > minutesPerWeek {
+			ranges = append(ranges, weeklyRange{timeRange{0, offset + r.end - minutesPerWeek}, label})
+		}
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].start < ranges[i-1].end {
+			return fmt.Errorf("영업 시간이 겹칩니다: %s, %s", ranges[i-1].label, ranges[i].label)
+		}
+	}
+
+	seenHolidays := make(map[string]bool, len(s.Holidays))
+	for _, holiday := range s.Holidays {
+		if dayIndex(holiday.DayOfWeek) < 0 {
+			return fmt.Errorf("알 수 없는 요일입니다: %s", holiday.DayOfWeek)
+		}
+		if holiday.WeekOfMonth != nil && (*holiday.WeekOfMonth < 1 || *holiday.WeekOfMonth > 5) {
+			return fmt.Errorf("정기 휴무 주차는 1~5 사이여야 합니다: %d", *holiday.WeekOfMonth)
+		}
+
+		key := string(holiday.DayOfWeek)
+		if holiday.WeekOfMonth != nil {
+			key = fmt.Sprintf("%s-%d", holiday.DayOfWeek, *holiday.WeekOfMonth)
+		}
+		if seenHolidays[key] {
+			return fmt.Errorf("정기 휴무일이 중복되었습니다: %s", key)
+		}
+		seenHolidays[key] = true
+	}
+
+	seenDates := make(map[string]bool, len(s.Exceptions))
+	for _, exception := range s.Exceptions {
+		if _, err := time.Parse(ScheduleDateLayout, exception.Date); err != nil {
+			return fmt.Errorf("예외 날짜 %q는 YYYY-MM-DD 형식이어야 합니다", exception.Date)
+		}
+		if seenDates[exception.Date] {
+			return fmt.Errorf("예외 날짜가 중복되었습니다: %s", exception.Date)
+		}
+		seenDates[exception.Date] = true
+
+		if exception.Closed {
+			if exception.OpenTime != nil || exception.CloseTime != nil {
+				return fmt.Errorf("휴무 예외 날짜(%s)에는 영업 시간을 지정할 수 없습니다", exception.Date)
+			}
+			continue
+		}
+		if exception.OpenTime == nil || exception.CloseTime == nil {
+			return fmt.Errorf("예외 날짜(%s)의 영업 시간이 필요합니다", exception.Date)
+		}
+		if _, err := parseTimeRange(*exception.OpenTime, *exception.CloseTime); err != nil {
+			return fmt.Errorf("예외 날짜(%s) 영업 시간 오류: %w", exception.Date, err)
+		}
+	}
+
+	// 예외 날짜는 주간 영업 시간 대신 적용되므로, 자정을 넘는 구간이 앞뒤 날짜와 겹치는지 실제 날짜로 확인
+	for _, exception := range s.Exceptions {
+		date, _ := time.Parse(ScheduleDateLayout, exception.Date)
+		for _, start := range []time.Time{date.AddDate(0, 0, -1), date} {
+			if err := s.validateSpillOver(start); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateSpillOver는 date에 시작해 자정을 넘기는 영업 구간이 다음 날 영업 구간과 겹치지 않는지 확인합니다.
+// 두 날짜 모두 예외 날짜, 정기 휴무일, 주간 영업 시간 순으로 실제 적용되는 구간을 비교합니다.
+func (s *BusinessSchedule) validateSpillOver(date time.Time) error {
+	next := date.AddDate(0, 0, 1)
+	for _, r := range s.rangesOn(date) {
+		if r.end <= minutesPerDay {
+			continue
+		}
+		for _, n := range s.rangesOn(next) {
+			if n.start < r.end-minutesPerDay {
+				return fmt.Errorf("%s에 시작한 영업 시간이 다음 날(%s) 영업 시간과 겹칩니다",
+					date.Format(ScheduleDateLayout), next.Format(ScheduleDateLayout))
+			}
+		}
+	}
+	return nil
+}
+
+// IsOpenAt은 주어진 시각에 영업 중인지 Asia/Seoul 기준으로 계산합니다.
+// 전날 시작해 자정을 넘긴 영업 구간도 반영합니다. 매장 상태(OPEN/CLOSED/HIDDEN)는 고려하지 않습니다.
+func (s *BusinessSchedule) IsOpenAt(t time.Time) bool {
+	local := t.In(SeoulLocation)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, SeoulLocation)
+	minute := local.Hour()*60 + local.Minute()
+
+	for _, r := range s.rangesOn(today) {
+		if minute >= r.start && minute < r.end {
+			return true
+		}
+	}
+	for _, r := range s.rangesOn(today.AddDate(0, 0, -1)) {
+		if minute+minutesPerDay >= r.start && minute+minutesPerDay < r.end {
+			return true
+		}
+	}
+	return false
+}
+
+// rangesOn은 특정 날짜에 시작하는 영업 구간을 예외 날짜, 정기 휴무일, 주간 영업 시간 순으로 결정합니다.
+func (s *BusinessSchedule) rangesOn(date time.Time) []timeRange {
+	key := date.Format(ScheduleDateLayout)
+	for _, exception := range s.Exceptions {
+		if exception.Date != key {
+			continue
+		}
+		if exception.Closed || exception.OpenTime == nil || exception.CloseTime == nil {
+			return nil
+		}
+		r, err := parseTimeRange(*exception.OpenTime, *exception.CloseTime)
+		if err != nil {
+			return nil
+		}
+		return []timeRange{r}
+	}
+
+	day := DayOfWeekOf(date.Weekday())
+	week := (date.Day()-1)/7 + 1
+	for _, holiday := range s.Holidays {
+		if holiday.DayOfWeek == day && (holiday.WeekOfMonth == nil || *holiday.WeekOfMonth == week) {
+			return nil
+		}
+	}
+
+	var ranges []timeRange
+	for _, hour := range s.Hours {
+		if hour.DayOfWeek != day {
+			continue
+		}
+		if r, err := parseTimeRange(hour.OpenTime, hour.CloseTime); err == nil {
+			ranges = append(ranges, r)
+		}
+	}
+	return ranges
+}
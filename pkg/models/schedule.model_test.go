@@ -0,0 +1,131 @@
+package models
+
+import "testing"
+
+func weeklyHours(entries ...[3]string) []BusinessHour {
+	result := make([]BusinessHour, len(entries))
+	for i, e := range entries {
+		result[i] = BusinessHour{DayOfWeek: DayOfWeek(e[0]), OpenTime: e[1], CloseTime: e[2]}
+	}
+	return result
+}
+
+func openException(date, openTime, closeTime string) BusinessHourException {
+	return BusinessHourException{Date: date, OpenTime: &openTime, CloseTime: &closeTime}
+}
+
+func TestBusinessScheduleValidate(t *testing.T) {
+	secondWeek := 2
+
+	// 2025-03-03은 월요일, 2025-03-09는 일요일
+	tests := []struct {
+		name     string
+		schedule BusinessSchedule
+		wantErr  bool
+	}{
+		{
+			name:     "겹치지 않는 주간 영업 시간",
+			schedule: BusinessSchedule{Hours: weeklyHours([3]string{"MON", "0900", "1400"}, [3]string{"MON", "1700", "2200"}, [3]string{"TUE", "0900", "2200"})},
+		},
+		{
+			name:     "같은 요일 겹침",
+			schedule: BusinessSchedule{Hours: weeklyHours([3]string{"MON", "0900", "1500"}, [3]string{"MON", "1400", "2200"})},
+			wantErr:  true,
+		},
+		{
+			name:     "자정 넘긴 구간과 다음 요일 겹침",
+			schedule: BusinessSchedule{Hours: weeklyHours([3]string{"MON", "2200", "0200"}, [3]string{"TUE", "0100", "0900"})},
+			wantErr:  true,
+		},
+		{
+			name:     "자정 넘긴 구간 끝과 다음 요일 시작이 맞닿음",
+			schedule: BusinessSchedule{Hours: weeklyHours([3]string{"MON", "2200", "0200"}, [3]string{"TUE", "0200", "0900"})},
+		},
+		{
+			name:     "일요일 밤에서 월요일로 넘어가 겹침",
+			schedule: BusinessSchedule{Hours: weeklyHours([3]string{"SUN", "2200", "0300"}, [3]string{"MON", "0200", "1000"})},
+			wantErr:  true,
+		},
+		{
+			name:     "일요일 밤에서 월요일로 넘어가고 맞닿음",
+			schedule: BusinessSchedule{Hours: weeklyHours([3]string{"SUN", "2200", "0300"}, [3]string{"MON", "0300", "1000"})},
+		},
+		{
+			name: "자정 넘긴 예외가 다음 날 주간 영업 시간과 겹침",
+			schedule: BusinessSchedule{
+				Hours:      weeklyHours([3]string{"TUE", "0100", "0900"}),
+				Exceptions: []BusinessHourException{openException("2025-03-03", "2000", "0300")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "자정 넘긴 예외가 다음 날 예외와 겹침",
+			schedule: BusinessSchedule{
+				Exceptions: []BusinessHourException{
+					openException("2025-03-03", "2000", "0300"),
+					openException("2025-03-04", "0200", "1000"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "일요일 예외가 월요일 주간 영업 시간과 겹침",
+			schedule: BusinessSchedule{
+				Hours:      weeklyHours([3]string{"MON", "0100", "1000"}),
+				Exceptions: []BusinessHourException{openException("2025-03-09", "2200", "0200")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "전날 자정 넘긴 주간 영업 시간이 예외와 겹침",
+			schedule: BusinessSchedule{
+				Hours:      weeklyHours([3]string{"MON", "2200", "0400"}),
+				Exceptions: []BusinessHourException{openException("2025-03-04", "0300", "1200")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "자정 넘긴 예외의 다음 날이 휴무 예외",
+			schedule: BusinessSchedule{
+				Hours: weeklyHours([3]string{"TUE", "0100", "0900"}),
+				Exceptions: []BusinessHourException{
+					openException("2025-03-03", "2000", "0300"),
+					{Date: "2025-03-04", Closed: true},
+				},
+			},
+		},
+		{
+			name: "자정 넘긴 예외의 다음 날이 정기 휴무일",
+			schedule: BusinessSchedule{
+				Hours:      weeklyHours([3]string{"TUE", "0100", "0900"}),
+				Holidays:   []RestaurantHoliday{{DayOfWeek: TUESDAY, WeekOfMonth: nil}},
+				Exceptions: []BusinessHourException{openException("2025-03-03", "2000", "0300")},
+			},
+		},
+		{
+			name: "다음 날이 해당 주차 정기 휴무일이 아님",
+			schedule: BusinessSchedule{
+				Hours:      weeklyHours([3]string{"TUE", "0100", "0900"}),
+				Holidays:   []RestaurantHoliday{{DayOfWeek: TUESDAY, WeekOfMonth: &secondWeek}},
+				Exceptions: []BusinessHourException{openException("2025-03-03", "2000", "0300")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "예외가 주간 영업 시간을 대신해 겹치지 않음",
+			schedule: BusinessSchedule{
+				Hours:      weeklyHours([3]string{"MON", "2200", "0400"}, [3]string{"TUE", "0900", "1800"}),
+				Exceptions: []BusinessHourException{openException("2025-03-03", "1000", "2000")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() 오류 = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RestaurantRequestAction은 매장 요청에 적용할 수 있는 행위를 나타내는 열거형입니다.
+type RestaurantRequestAction string
+
+const (
+	REQUEST_APPROVE  RestaurantRequestAction = "APPROVE"  // 승인
+	REQUEST_REJECT   RestaurantRequestAction = "REJECT"   // 거절
+	REQUEST_REOPEN   RestaurantRequestAction = "REOPEN"   // 거절된 요청 재검토
+	REQUEST_WITHDRAW RestaurantRequestAction = "WITHDRAW" // 요청 철회
+)
+
+// RestaurantAction은 매장에 적용할 수 있는 행위를 나타내는 열거형입니다.
+type RestaurantAction string
+
+const (
+	RESTAURANT_ACTIVATE RestaurantAction = "ACTIVATE" // 생성 요청 승인으로 노출 대기 상태 전환
+	RESTAURANT_OPEN     RestaurantAction = "OPEN"     // 영업 시작
+	RESTAURANT_CLOSE    RestaurantAction = "CLOSE"    // 영업 종료
+	RESTAURANT_HIDE     RestaurantAction = "HIDE"     // 숨김
+)
+
+// OrderAction은 주문에 적용할 수 있는 행위를 나타내는 열거형입니다.
+type OrderAction string
+
+const (
+	ORDER_ACCEPT   OrderAction = "ACCEPT"   // 주문 접수
+	ORDER_COMPLETE OrderAction = "COMPLETE" // 주문 완료
+	ORDER_REJECT   OrderAction = "REJECT"   // 주문 거절
+	ORDER_CANCEL   OrderAction = "CANCEL"   // 주문 취소
+)
+
+// TransitionError는 허용되지 않은 상태 전이를 나타냅니다.
+type TransitionError struct {
+	Entity string
+	From   string
+	Action string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s 상태 %s에서 %s 처리를 할 수 없습니다", e.Entity, e.From, e.Action)
+}
+
+// Transition은 하나의 상태 전이 규칙입니다.
+type Transition[S ~string, A ~string, I any] struct {
+	Action A
+	From   []S
+	To     S
+	Guard  func(input I) error // 전이 전 추가 검증 (선택적)
+}
+
+// StateMachine은 선언된 전이 규칙에 따라 다음 상태를 결정합니다.
+type StateMachine[S ~string, A ~string, I any] struct {
+	entity      string
+	transitions map[A]Transition[S, A, I]
+}
+
+// NewStateMachine은 전이 규칙 목록으로 StateMachine을 생성합니다.
+func NewStateMachine[S ~string, A ~string, I any](entity string, transitions ...Transition[S, A, I]) *StateMachine[S, A, I] {
+	m := &StateMachine[S, A, I]{
+		entity:      entity,
+		transitions: make(map[A]Transition[S, A, I], len(transitions)),
+	}
+	for _, t := range transitions {
+		m.transitions[t.Action] = t
+	}
+	return m
+}
+
+// Can은 현재 상태에서 해당 행위가 허용되는지 확인합니다.
+func (m *StateMachine[S, A, I]) Can(current S, action A) bool {
+	t, ok := m.transitions[action]
+	if !ok {
+		return false
+	}
+	for _, from := range t.From {
+		if from == current {
+			return true
+		}
+	}
+	return false
+}
+
+// Next는 전이 규칙과 가드를 검증한 뒤 다음 상태를 반환합니다.
+// 허용되지 않은 전이는 *TransitionError를, 가드 실패는 가드의 에러를 그대로 반환합니다.
+func (m *StateMachine[S, A, I]) Next(current S, action A, input I) (S, error) {
+	if !m.Can(current, action) {
+		return current, &TransitionError{Entity: m.entity, From: string(current), Action: string(action)}
+	}
+
+	t := m.transitions[action]
+	if t.Guard != nil {
+		if err := t.Guard(input); err != nil {
+			return current, err
+		}
+	}
+	return t.To, nil
+}
+
+// RestaurantRequestTransitionInput은 매장 요청 전이 가드에 전달되는 값입니다.
+type RestaurantRequestTransitionInput struct {
+	Request           *RestaurantRequest
+	RejectReasonCodes []string
+	License           *BusinessLicenseVerification // 요청의 사업자등록번호 조회 결과
+	LicenseOverride   bool                         // 관리자가 사업자등록번호 검증 실패를 무시하고 승인
+}
+
+// RestaurantRequestMachine은 매장 요청 상태 전이 규칙입니다.
+var RestaurantRequestMachine = NewStateMachine("매장 요청",
+	Transition[RestaurantRequestStatus, RestaurantRequestAction, RestaurantRequestTransitionInput]{
+		Action: REQUEST_APPROVE,
+		From:   []RestaurantRequestStatus{PENDING},
+		To:     APPROVED,
+		Guard:  licenseVerified,
+	},
+	Transition[RestaurantRequestStatus, RestaurantRequestAction, RestaurantRequestTransitionInput]{
+		Action: REQUEST_REJECT,
+		From:   []RestaurantRequestStatus{PENDING},
+		To:     REJECTED,
+		Guard: func(in RestaurantRequestTransitionInput) error {
+			if len(in.RejectReasonCodes) == 0 {
+				return errors.New("거절 사유 코드가 필요합니다")
+			}
+			return nil
+		},
+	},
+	Transition[RestaurantRequestStatus, RestaurantRequestAction, RestaurantRequestTransitionInput]{
+		Action: REQUEST_REOPEN,
+		From:   []RestaurantRequestStatus{REJECTED},
+		To:     PENDING,
+	},
+	Transition[RestaurantRequestStatus, RestaurantRequestAction, RestaurantRequestTransitionInput]{
+		Action: REQUEST_WITHDRAW,
+		From:   []RestaurantRequestStatus{PENDING},
+		To:     WITHDRAWN,
+	},
+)
+
+// RestaurantMachine은 매장 상태 전이 규칙입니다.
+var RestaurantMachine = NewStateMachine("매장",
+	Transition[RestaurantStatus, RestaurantAction, *Restaurant]{
+		Action: RESTAURANT_ACTIVATE,
+		From:   []RestaurantStatus{REQUESTED},
+		To:     HIDDEN,
+	},
+	Transition[RestaurantStatus, RestaurantAction, *Restaurant]{
+		Action: RESTAURANT_OPEN,
+		From:   []RestaurantStatus{HIDDEN, CLOSED},
+		To:     OPEN,
+		Guard:  restaurantNotDeleted,
+	},
+	Transition[RestaurantStatus, RestaurantAction, *Restaurant]{
+		Action: RESTAURANT_CLOSE,
+		From:   []RestaurantStatus{OPEN},
+		To:     CLOSED,
+		Guard:  restaurantNotDeleted,
+	},
+	Transition[RestaurantStatus, RestaurantAction, *Restaurant]{
+		Action: RESTAURANT_HIDE,
+		From:   []RestaurantStatus{OPEN, CLOSED},
+		To:     HIDDEN,
+	},
+)
+
+// OrderMachine은 주문 상태 전이 규칙입니다. 완료/거절/취소된 주문은 더 이상 전이할 수 없습니다.
+var OrderMachine = NewStateMachine("주문",
+	Transition[OrderStatus, OrderAction, *Order]{
+		Action: ORDER_ACCEPT,
+		From:   []OrderStatus{ORDER_NEW},
+		To:     ORDER_PROCESSING,
+	},
+	Transition[OrderStatus, OrderAction, *Order]{
+		Action: ORDER_COMPLETE,
+		From:   []OrderStatus{ORDER_PROCESSING},
+		To:     ORDER_COMPLETED,
+	},
+	Transition[OrderStatus, OrderAction, *Order]{
+		Action: ORDER_REJECT,
+		From:   []OrderStatus{ORDER_NEW},
+		To:     ORDER_REJECTED,
+	},
+	Transition[OrderStatus, OrderAction, *Order]{
+		Action: ORDER_CANCEL,
+		From:   []OrderStatus{ORDER_NEW, ORDER_PROCESSING},
+		To:     ORDER_CANCELLED,
+	},
+)
+
+// restaurantRequestEffects는 요청 전이 성공 시 요청 유형별로 연결된 매장에 적용할 행위입니다.
+var restaurantRequestEffects = map[RestaurantRequestAction]map[RestaurantRequestType]RestaurantAction{
+	REQUEST_APPROVE: {
+		CREATE: RESTAURANT_ACTIVATE,
+	},
+}
+
+// RestaurantEffectOf는 요청 전이에 따라 매장에 적용해야 할 행위를 반환합니다.
+func RestaurantEffectOf(action RestaurantRequestAction, requestType RestaurantRequestType) (RestaurantAction, bool) {
+	effect, ok := restaurantRequestEffects[action][requestType]
+	return effect, ok
+}
+
+// licenseVerified는 사업자등록번호가 계속사업자로 확인된 요청만 승인되도록 합니다.
+// 사업자등록번호가 없는 수정 요청은 확인하지 않으며, 관리자가 명시적으로 무시한 경우 통과합니다.
+func licenseVerified(in RestaurantRequestTransitionInput) error {
+	if in.LicenseOverride {
+		return nil
+	}
+	if in.Request != nil && in.Request.BusinessLicenseNumber == nil {
+		if in.Request.Type == UPDATE {
+			return nil
+		}
+		return errors.New("사업자등록번호가 없는 요청은 승인할 수 없습니다")
+	}
+	if in.License == nil {
+		return errors.New("사업자등록번호 상태를 확인하지 못했습니다")
+	}
+	if !in.License.Passed() {
+		return fmt.Errorf("사업자등록번호 검증에 실패했습니다 (상태: %s)", in.License.Status)
+	}
+	return nil
+}
+
+func restaurantNotDeleted(r *Restaurant) error {
+	if r != nil && r.DeletedAt != nil {
+		return errors.New("삭제된 매장입니다")
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRestaurantRequestMachineTransitions(t *testing.T) {
+	number := "2208162517"
+	active := &BusinessLicenseVerification{LicenseNumber: number, Status: LICENSE_ACTIVE}
+	closed := &BusinessLicenseVerification{LicenseNumber: number, Status: LICENSE_CLOSED}
+	createRequest := &RestaurantRequest{Type: CREATE, BusinessLicenseNumber: &number}
+
+	tests := []struct {
+		name    string
+		from    RestaurantRequestStatus
+		action  RestaurantRequestAction
+		input   RestaurantRequestTransitionInput
+		want    RestaurantRequestStatus
+		wantErr bool // 가드 실패
+	}{
+		{"승인", PENDING, REQUEST_APPROVE, RestaurantRequestTransitionInput{Request: createRequest, License: active}, APPROVED, false},
+		{"거절", PENDING, REQUEST_REJECT, RestaurantRequestTransitionInput{RejectReasonCodes: []string{"BLURRY_LICENSE"}}, REJECTED, false},
+		{"재검토", REJECTED, REQUEST_REOPEN, RestaurantRequestTransitionInput{}, PENDING, false},
+		{"철회", PENDING, REQUEST_WITHDRAW, RestaurantRequestTransitionInput{}, WITHDRAWN, false},
+		{"사업자번호 없는 수정 요청 승인", PENDING, REQUEST_APPROVE, RestaurantRequestTransitionInput{Request: &RestaurantRequest{Type: UPDATE}}, APPROVED, false},
+		{"검증 실패 무시 승인", PENDING, REQUEST_APPROVE, RestaurantRequestTransitionInput{Request: createRequest, License: closed, LicenseOverride: true}, APPROVED, false},
+
+		{"거절 사유 코드 없음", PENDING, REQUEST_REJECT, RestaurantRequestTransitionInput{}, PENDING, true},
+		{"사업자번호 없는 생성 요청 승인", PENDING, REQUEST_APPROVE, RestaurantRequestTransitionInput{Request: &RestaurantRequest{Type: CREATE}}, PENDING, true},
+		{"사업자번호 미조회 승인", PENDING, REQUEST_APPROVE, RestaurantRequestTransitionInput{Request: createRequest}, PENDING, true},
+		{"폐업자 승인", PENDING, REQUEST_APPROVE, RestaurantRequestTransitionInput{Request: createRequest, License: closed}, PENDING, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RestaurantRequestMachine.Next(tt.from, tt.action, tt.input)
+			if tt.wantErr {
+				var transitionErr *TransitionError
+				if err == nil || errors.As(err, &transitionErr) {
+					t.Fatalf("Next() 오류 = %v, 가드 실패를 기대합니다", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Next() 오류: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Next() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestaurantRequestMachineRejectsTransitions(t *testing.T) {
+	allowed := map[RestaurantRequestAction][]RestaurantRequestStatus{
+		REQUEST_APPROVE:  {PENDING},
+		REQUEST_REJECT:   {PENDING},
+		REQUEST_REOPEN:   {REJECTED},
+		REQUEST_WITHDRAW: {PENDING},
+	}
+	statuses := []RestaurantRequestStatus{PENDING, APPROVED, REJECTED, WITHDRAWN}
+
+	for action, froms := range allowed {
+		for _, from := range statuses {
+			if containsStatus(froms, from) {
+				continue
+			}
+
+			got, err := RestaurantRequestMachine.Next(from, action, RestaurantRequestTransitionInput{LicenseOverride: true, RejectReasonCodes: []string{"X"}})
+			var transitionErr *TransitionError
+			if !errors.As(err, &transitionErr) {
+				t.Errorf("%s에서 %s: 오류 = %v, *TransitionError를 기대합니다", from, action, err)
+				continue
+			}
+			if got != from || transitionErr.From != string(from) || transitionErr.Action != string(action) {
+				t.Errorf("%s에서 %s: 상태 %s, 오류 %+v", from, action, got, transitionErr)
+			}
+		}
+	}
+
+	if _, err := RestaurantRequestMachine.Next(PENDING, RestaurantRequestAction("UNKNOWN"), RestaurantRequestTransitionInput{}); err == nil {
+		t.Error("정의되지 않은 행위는 거부해야 합니다")
+	}
+}
+
+func TestRestaurantMachineTransitions(t *testing.T) {
+	deletedAt := time.Now()
+	deleted := &Restaurant{DeletedAt: &deletedAt}
+
+	tests := []struct {
+		name       string
+		from       RestaurantStatus
+		action     RestaurantAction
+		restaurant *Restaurant
+		want       RestaurantStatus
+		wantErr    bool
+	}{
+		{"승인으로 노출 대기", REQUESTED, RESTAURANT_ACTIVATE, &Restaurant{}, HIDDEN, false},
+		{"숨김에서 영업 시작", HIDDEN, RESTAURANT_OPEN, &Restaurant{}, OPEN, false},
+		{"영업 종료에서 영업 시작", CLOSED, RESTAURANT_OPEN, &Restaurant{}, OPEN, false},
+		{"영업 종료", OPEN, RESTAURANT_CLOSE, &Restaurant{}, CLOSED, false},
+		{"영업 중 숨김", OPEN, RESTAURANT_HIDE, &Restaurant{}, HIDDEN, false},
+		{"영업 종료 숨김", CLOSED, RESTAURANT_HIDE, &Restaurant{}, HIDDEN, false},
+		{"삭제된 매장 숨김", OPEN, RESTAURANT_HIDE, deleted, HIDDEN, false},
+
+		{"요청 중 영업 시작", REQUESTED, RESTAURANT_OPEN, &Restaurant{}, REQUESTED, true},
+		{"숨김 상태 영업 종료", HIDDEN, RESTAURANT_CLOSE, &Restaurant{}, HIDDEN, true},
+		{"숨김 상태 숨김", HIDDEN, RESTAURANT_HIDE, &Restaurant{}, HIDDEN, true},
+		{"영업 중 재활성화", OPEN, RESTAURANT_ACTIVATE, &Restaurant{}, OPEN, true},
+		{"삭제된 매장 영업 시작", HIDDEN, RESTAURANT_OPEN, deleted, HIDDEN, true},
+		{"삭제된 매장 영업 종료", OPEN, RESTAURANT_CLOSE, deleted, OPEN, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RestaurantMachine.Next(tt.from, tt.action, tt.restaurant)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Next() 오류 = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Next() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestaurantEffectOf(t *testing.T) {
+	if effect, ok := RestaurantEffectOf(REQUEST_APPROVE, CREATE); !ok || effect != RESTAURANT_ACTIVATE {
+		t.Errorf("생성 요청 승인 = %s, %v, want ACTIVATE", effect, ok)
+	}
+	if _, ok := RestaurantEffectOf(REQUEST_APPROVE, UPDATE); ok {
+		t.Error("수정 요청 승인은 매장 상태를 바꾸지 않아야 합니다")
+	}
+	if _, ok := RestaurantEffectOf(REQUEST_REJECT, CREATE); ok {
+		t.Error("거절은 매장 상태를 바꾸지 않아야 합니다")
+	}
+}
+
+func containsStatus(statuses []RestaurantRequestStatus, status RestaurantRequestStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// UploadKeyPolicy는 역할별로 presigned URL을 발급할 수 있는 키 접두사입니다.
+// 접두사의 {userId}는 호출자 ID로 바뀌며, 빈 접두사는 허용된 버킷의 모든 키를 뜻합니다.
+type UploadKeyPolicy struct {
+	Read  []string // GET URL을 발급할 수 있는 키 접두사
+	Write string   // PUT URL로 업로드할 키 접두사. 업로드 키는 이 아래에 서버가 생성합니다
+}
+
+// UploadKeyPolicies는 역할별 키 접두사 정책입니다. 정책이 없는 역할은 presigned URL을 발급받을 수 없습니다.
+var UploadKeyPolicies = map[Role]UploadKeyPolicy{
+	CUSTOMER: {Read: []string{"users/{userId}/"}, Write: "users/{userId}/"},
+	OWNER:    {Read: []string{"restaurants/{userId}/"}, Write: "restaurants/{userId}/"},
+	ADMIN:    {Read: []string{""}, Write: "admin/{userId}/"},
+}
+
+// ReadPrefixes는 호출자 ID를 채운 읽기 접두사를 반환합니다.
+func (p UploadKeyPolicy) ReadPrefixes(userID string) []string {
+	prefixes := make([]string, len(p.Read))
+	for i, prefix := range p.Read {
+		prefixes[i] = strings.ReplaceAll(prefix, "{userId}", userID)
+	}
+	return prefixes
+}
+
+// WritePrefix는 호출자 ID를 채운 업로드 접두사를 반환합니다.
+func (p UploadKeyPolicy) WritePrefix(userID string) string {
+	return strings.ReplaceAll(p.Write, "{userId}", userID)
+}
+
+// UploadPurpose는 업로드 용도입니다. 용도마다 허용하는 파일 형식과 키 경로가 다릅니다.
+type UploadPurpose string
+
+const (
+	UPLOAD_LICENSE_IMAGE    UploadPurpose = "LICENSE_IMAGE"    // 사업자등록증 이미지
+	UPLOAD_MENU_IMAGE       UploadPurpose = "MENU_IMAGE"       // 메뉴 이미지
+	UPLOAD_RESTAURANT_IMAGE UploadPurpose = "RESTAURANT_IMAGE" // 매장 이미지
+)
+
+// UploadPurposePolicy는 업로드 용도별 키 경로와 허용 Content-Type입니다.
+type UploadPurposePolicy struct {
+	Segment      string   // 업로드 접두사 아래 용도 경로 ({업로드 접두사}{Segment}/{파일})
+	ContentTypes []string // 허용 Content-Type. 업로드 후 실제 파일 형식도 이 안에 있어야 합니다
+	MaxFileSize  int64    // 용도별 최대 파일 크기 (바이트). 요청한 maxFileSize가 더 크면 이 값으로 줄입니다
+}
+
+// UploadPurposePolicies는 업로드 용도별 정책입니다. 공개 버킷에서 스크립트를 실행할 수 있는 html, js, svg는 어떤 용도에도 허용하지 않습니다.
+var UploadPurposePolicies = map[UploadPurpose]UploadPurposePolicy{
+	UPLOAD_LICENSE_IMAGE:    {Segment: "license", ContentTypes: []string{"image/jpeg", "image/png", "application/pdf"}, MaxFileSize: 10 * 1024 * 1024},
+	UPLOAD_MENU_IMAGE:       {Segment: "menu", ContentTypes: []string{"image/jpeg", "image/png", "image/webp"}, MaxFileSize: 5 * 1024 * 1024},
+	UPLOAD_RESTAURANT_IMAGE: {Segment: "restaurant", ContentTypes: []string{"image/jpeg", "image/png", "image/webp"}, MaxFileSize: 5 * 1024 * 1024},
+}
+
+// Allows는 Content-Type이 용도에 허용되는지 확인합니다.
+func (p UploadPurposePolicy) Allows(contentType string) bool {
+	for _, allowed := range p.ContentTypes {
+		if allowed == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseUploadKey는 presigned 업로드로 만든 키에서 업로드 용도를 찾습니다.
+// 키가 "{역할별 업로드 접두사}{용도 경로}/{파일}" 형식이 아니면 false를 반환합니다.
+func ParseUploadKey(key string) (UploadPurpose, bool) {
+	segments := strings.Split(key, "/")
+	if len(segments) != 4 || segments[1] == "" || segments[3] == "" {
+		return "", false
+	}
+
+	prefix := segments[0] + "/{userId}/"
+	matched := false
+	for _, policy := range UploadKeyPolicies {
+		if policy.Write == prefix {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return "", false
+	}
+
+	for purpose, policy := range UploadPurposePolicies {
+		if policy.Segment == segments[2] {
+			return purpose, true
+		}
+	}
+	return "", false
+}
+
+// UploadVerificationStatus는 업로드 검증 결과 상태입니다.
+type UploadVerificationStatus string
+
+const (
+	UPLOAD_VERIFIED    UploadVerificationStatus = "VERIFIED"    // 실제 파일 형식이 선언한 형식, 용도와 일치
+	UPLOAD_QUARANTINED UploadVerificationStatus = "QUARANTINED" // 불일치로 격리 접두사로 옮김
+	UPLOAD_SKIPPED     UploadVerificationStatus = "SKIPPED"     // presigned 업로드 키가 아니거나 이미 삭제됨
+)
+
+// UploadVerificationResult는 업로드 검증 1회 실행 결과입니다.
+type UploadVerificationResult struct {
+	Bucket        string                   `json:"bucket"`
+	Key           string                   `json:"key"`
+	Status        UploadVerificationStatus `json:"status"`
+	DeclaredType  string                   `json:"declaredType,omitempty"` // 업로드 시 Content-Type
+	DetectedType  string                   `json:"detectedType,omitempty"` // 앞부분 바이트로 판별한 형식
+	QuarantineKey string                   `json:"quarantineKey,omitempty"`
+	Reason        string                   `json:"reason,omitempty"`
+}
+
+// S3ObjectCreatedDetail은 EventBridge S3 "Object Created" 이벤트의 detail입니다.
+type S3ObjectCreatedDetail struct {
+	Bucket struct {
+		Name string `json:"name"`
+	} `json:"bucket"`
+	Object struct {
+		Key  string `json:"key"`
+		Size int64  `json:"size"`
+	} `json:"object"`
+}
+
+// UploadStatus는 업로드 등록부의 상태입니다.
+type UploadStatus string
+
+const (
+	UPLOAD_PENDING   UploadStatus = "PENDING"   // URL 발급 후 확인 전
+	UPLOAD_CONFIRMED UploadStatus = "CONFIRMED" // 객체를 확인하고 크기와 ETag를 기록함
+	UPLOAD_DELETED   UploadStatus = "DELETED"   // 정리 작업 또는 확인 실패로 객체를 삭제함
+)
+
+// Upload는 presigned 업로드 등록부 모델입니다.
+type Upload struct {
+	ID           string        `json:"id" db:"id"`
+	UserID       string        `json:"userId" db:"userId"`
+	Purpose      UploadPurpose `json:"purpose" db:"purpose"`
+	Bucket       string        `json:"bucket" db:"bucket"`
+	Key          string        `json:"key" db:"key"`
+	ContentType  string        `json:"contentType" db:"contentType"`
+	MaxFileSize  int64         `json:"maxFileSize" db:"maxFileSize"`
+	Status       UploadStatus  `json:"status" db:"status"`
+	Size         *int64        `json:"size,omitempty" db:"size"` // 확인 시 HEAD로 읽은 객체 크기
+	ETag         *string       `json:"etag,omitempty" db:"etag"`
+	CreatedAt    time.Time     `json:"createdAt" db:"createdAt"`
+	ConfirmedAt  *time.Time    `json:"confirmedAt,omitempty" db:"confirmedAt"`
+	DeletedAt    *time.Time    `json:"deletedAt,omitempty" db:"deletedAt"`
+	DeleteReason *string       `json:"deleteReason,omitempty" db:"deleteReason"`
+}
+
+// S3ObjectInfo는 HEAD로 조회한 S3 객체 메타데이터입니다.
+type S3ObjectInfo struct {
+	Size        int64
+	ETag        string
+	ContentType string
+}
+
+// UploadCleanupResult는 업로드 정리 작업 1회 실행 결과입니다.
+type UploadCleanupResult struct {
+	Scanned      int `json:"scanned"`
+	Unconfirmed  int `json:"unconfirmed"`  // TTL이 지나도록 확인되지 않아 삭제
+	Unreferenced int `json:"unreferenced"` // 확인 후 TTL이 지나도록 참조되지 않아 삭제
+	Failed       int `json:"failed"`
+}
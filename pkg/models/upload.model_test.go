@@ -0,0 +1,88 @@
+package models
+
+import "testing"
+
+func TestParseUploadKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		wantPurpose UploadPurpose
+		wantOK      bool
+	}{
+		{"점주 메뉴 이미지", "restaurants/u1/menu/a.png", UPLOAD_MENU_IMAGE, true},
+		{"고객 사업자등록증", "users/u1/license/a.pdf", UPLOAD_LICENSE_IMAGE, true},
+		{"관리자 매장 이미지", "admin/u1/restaurant/a.jpg", UPLOAD_RESTAURANT_IMAGE, true},
+		{"알 수 없는 역할 접두사", "others/u1/menu/a.png", "", false},
+		{"알 수 없는 용도 경로", "restaurants/u1/avatar/a.png", "", false},
+		{"사용자 ID 없음", "restaurants//menu/a.png", "", false},
+		{"파일 이름 없음", "restaurants/u1/menu/", "", false},
+		{"구간이 부족함", "restaurants/u1/a.png", "", false},
+		{"구간이 많음", "restaurants/u1/menu/x/a.png", "", false},
+		{"격리된 키", "quarantine/restaurants/u1/menu/a.png", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purpose, ok := ParseUploadKey(tt.key)
+			if purpose != tt.wantPurpose || ok != tt.wantOK {
+				t.Errorf("ParseUploadKey(%q) = (%q, %v), want (%q, %v)", tt.key, purpose, ok, tt.wantPurpose, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestUploadKeyPolicies(t *testing.T) {
+	tests := []struct {
+		role        Role
+		wantRead    []string
+		wantWrite   string
+		wantAllowed bool
+	}{
+		{CUSTOMER, []string{"users/u1/"}, "users/u1/", true},
+		{OWNER, []string{"restaurants/u1/"}, "restaurants/u1/", true},
+		{ADMIN, []string{""}, "admin/u1/", true},
+		{Role("GUEST"), nil, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			policy, ok := UploadKeyPolicies[tt.role]
+			if ok != tt.wantAllowed {
+				t.Fatalf("UploadKeyPolicies[%s] 존재 = %v, want %v", tt.role, ok, tt.wantAllowed)
+			}
+			if !ok {
+				return
+			}
+
+			read := policy.ReadPrefixes("u1")
+			if len(read) != len(tt.wantRead) {
+				t.Fatalf("ReadPrefixes = %q, want %q", read, tt.wantRead)
+			}
+			for i := range read {
+				if read[i] != tt.wantRead[i] {
+					t.Errorf("ReadPrefixes[%d] = %q, want %q", i, read[i], tt.wantRead[i])
+				}
+			}
+			if got := policy.WritePrefix("u1"); got != tt.wantWrite {
+				t.Errorf("WritePrefix = %q, want %q", got, tt.wantWrite)
+			}
+		})
+	}
+}
+
+func TestUploadPurposePolicyAllows(t *testing.T) {
+	for purpose, policy := range UploadPurposePolicies {
+		for _, contentType := range []string{"text/html", "application/javascript", "image/svg+xml"} {
+			if policy.Allows(contentType) {
+				t.Errorf("%s 용도가 %s를 허용합니다", purpose, contentType)
+			}
+		}
+	}
+
+	if !UploadPurposePolicies[UPLOAD_LICENSE_IMAGE].Allows("application/pdf") {
+		t.Error("LICENSE_IMAGE가 application/pdf를 허용하지 않습니다")
+	}
+	if UploadPurposePolicies[UPLOAD_MENU_IMAGE].Allows("application/pdf") {
+		t.Error("MENU_IMAGE가 application/pdf를 허용합니다")
+	}
+}
@@ -1,10 +1,21 @@
 package models
 
+import "time"
+
 // User는 사용자 모델입니다.
 type User struct {
-	ID    string `json:"id" db:"id"`
-	Email string `json:"email" db:"email"`
-	Name  string `json:"name" db:"name"`
+	ID            string     `json:"id" db:"id"`
+	Email         string     `json:"email" db:"email"`
+	Name          string     `json:"name" db:"name"`
+	Role          Role       `json:"role,omitempty" db:"role"`
+	SuspendedAt   *time.Time `json:"suspendedAt,omitempty" db:"suspendedAt"`
+	SuspendedBy   *string    `json:"suspendedBy,omitempty" db:"suspendedBy"`
+	SuspendReason *string    `json:"suspendReason,omitempty" db:"suspendReason"`
+}
+
+// IsSuspended는 계정이 정지 상태인지 확인합니다.
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
 }
 
 // Role은 사용자 역할입니다.
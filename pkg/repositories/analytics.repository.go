@@ -0,0 +1,277 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// AnalyticsRepository는 관리자 매출/주문 분석 집계 쿼리를 처리합니다.
+// 주문 시각("createdAt")은 UTC로 저장되어 있으며, 구간 경계는 Asia/Seoul 기준으로 계산합니다.
+type AnalyticsRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewAnalyticsRepository는 새 AnalyticsRepository 인스턴스를 생성합니다.
+func NewAnalyticsRepository(dbPool *pgxpool.Pool) *AnalyticsRepository {
+	return &AnalyticsRepository{
+		dbPool: dbPool,
+	}
+}
+
+// validOrderCondition은 GMV와 순위에 포함하는 유효 주문(취소/거절 제외) 조건입니다.
+const validOrderCondition = `"status" NOT IN ('CANCELLED', 'REJECTED')`
+
+// analyticsOrders는 기간/매장 조건에 맞는 주문 CTE와 파라미터를 만듭니다.
+// withTotal이 true면 주문별 메뉴 가격 × 수량 합계("total")를 함께 계산합니다.
+func analyticsOrders(query dto.AnalyticsQuery, withTotal bool) (string, []interface{}) {
+	params := []interface{}{query.From.UTC(), query.To.UTC()}
+
+	columns := `o."id", o."restaurantId", o."status", o."createdAt"`
+	join := ""
+	if withTotal {
+		// 주문 시점 가격이 저장되지 않으므로 주문 목록 합계와 같이 현재 메뉴 가격 기준 추정치입니다.
+		columns += `, COALESCE(t."total", 0) AS "total"`
+		join = `
+			LEFT JOIN LATERAL (
+				SELECT SUM(m."price" * om."quantity") AS "total"
+				FROM "OrderMenu" om
+				JOIN "RestaurantMenu" m ON m."id" = om."menuId"
+				WHERE om."orderId" = o."id"
+			) t ON TRUE`
+	}
+
+	where := `o."createdAt" >= $1 AND o."createdAt" < $2`
+	if query.RestaurantID != nil {
+		where += ` AND o."restaurantId" = $3`
+		params = append(params, *query.RestaurantID)
+	}
+
+	cte := fmt.Sprintf(`WITH orders AS (
+		SELECT %s
+		FROM "Order" o%s
+		WHERE %s
+	)`, columns, join, where)
+	return cte, params
+}
+
+// GetSalesBuckets는 구간별 주문 수, 취소/거절 수, GMV를 조회합니다. 주문이 없는 구간은 반환하지 않습니다.
+func (r *AnalyticsRepository) GetSalesBuckets(ctx context.Context, query dto.AnalyticsQuery) (map[string]models.SalesBucket, error) {
+	cte, params := analyticsOrders(query, true)
+	params = append(params, string(query.Interval))
+
+	queryStr := fmt.Sprintf(`%s
+		SELECT
+			date_trunc($%d::text, "createdAt" AT TIME ZONE 'UTC' AT TIME ZONE 'Asia/Seoul') AS "bucket",
+			COUNT(*),
+			COUNT(*) FILTER (WHERE "status" = 'CANCELLED'),
+			COUNT(*) FILTER (WHERE "status" = 'REJECTED'),
+			COALESCE(SUM("total") FILTER (WHERE %s), 0)::bigint
+		FROM orders
+		GROUP BY "bucket"
+		ORDER BY "bucket"
+	`, cte, len(params), validOrderCondition)
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("매출 집계 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := map[string]models.SalesBucket{}
+	for rows.Next() {
+		var bucket models.SalesBucket
+		var start time.Time // Asia/Seoul 벽시계 기준 구간 시작 (timestamp without time zone)
+		if err := rows.Scan(&start, &bucket.Orders, &bucket.CancelledOrders, &bucket.RejectedOrders, &bucket.GMV); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		bucket.Date = start.Format("2006-01-02")
+		result[bucket.Date] = bucket
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+// rankingOrder는 순위 정렬 기준에 맞는 ORDER BY 절을 반환합니다.
+func rankingOrder(sort dto.AnalyticsSort, ordersExpr, gmvExpr, idExpr string) string {
+	if sort == dto.ANALYTICS_SORT_ORDERS {
+		return fmt.Sprintf(`%s DESC, %s DESC, %s`, ordersExpr, gmvExpr, idExpr)
+	}
+	return fmt.Sprintf(`%s DESC, %s DESC, %s`, gmvExpr, ordersExpr, idExpr)
+}
+
+// GetTopRestaurants는 유효 주문 기준 매장 순위를 조회합니다.
+func (r *AnalyticsRepository) GetTopRestaurants(ctx context.Context, query dto.AnalyticsQuery) ([]models.TopRestaurant, error) {
+	cte, params := analyticsOrders(query, true)
+	params = append(params, query.Limit)
+
+	queryStr := fmt.Sprintf(`%s
+		SELECT o."restaurantId", COALESCE(r."name", ''), COUNT(*) AS "orders",
+			COALESCE(SUM(o."total"), 0)::bigint AS "gmv"
+		FROM orders o
+		LEFT JOIN "Restaurant" r ON r."id" = o."restaurantId"
+		WHERE o.%s
+		GROUP BY o."restaurantId", r."name"
+		ORDER BY %s
+		LIMIT $%d
+	`, cte, validOrderCondition, rankingOrder(query.Sort, `"orders"`, `"gmv"`, `o."restaurantId"`), len(params))
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("매장 순위 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	items := []models.TopRestaurant{}
+	for rows.Next() {
+		var item models.TopRestaurant
+		if err := rows.Scan(&item.RestaurantID, &item.Name, &item.Orders, &item.GMV); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return items, nil
+}
+
+// GetTopMenus는 유효 주문 기준 메뉴 순위를 조회합니다. 삭제된 메뉴는 집계하지 않습니다.
+func (r *AnalyticsRepository) GetTopMenus(ctx context.Context, query dto.AnalyticsQuery) ([]models.TopMenu, error) {
+	cte, params := analyticsOrders(query, false)
+	params = append(params, query.Limit)
+
+	queryStr := fmt.Sprintf(`%s
+		SELECT m."id", m."name", m."restaurantId", COALESCE(r."name", ''),
+			COUNT(DISTINCT o."id") AS "orders",
+			SUM(om."quantity")::bigint,
+			SUM(m."price" * om."quantity")::bigint AS "gmv"
+		FROM orders o
+		JOIN "OrderMenu" om ON om."orderId" = o."id"
+		JOIN "RestaurantMenu" m ON m."id" = om."menuId"
+		LEFT JOIN "Restaurant" r ON r."id" = m."restaurantId"
+		WHERE o.%s
+		GROUP BY m."id", m."name", m."restaurantId", r."name"
+		ORDER BY %s
+		LIMIT $%d
+	`, cte, validOrderCondition, rankingOrder(query.Sort, `"orders"`, `"gmv"`, `m."id"`), len(params))
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("메뉴 순위 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	items := []models.TopMenu{}
+	for rows.Next() {
+		var item models.TopMenu
+		if err := rows.Scan(&item.MenuID, &item.Name, &item.RestaurantID, &item.RestaurantName, &item.Orders, &item.Quantity, &item.GMV); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return items, nil
+}
+
+// GetCancellationRates는 매장별 취소/거절 비율을 높은 순으로 조회합니다.
+// 주문 수가 MinOrders 미만인 매장은 비율이 왜곡되므로 제외합니다.
+func (r *AnalyticsRepository) GetCancellationRates(ctx context.Context, query dto.AnalyticsQuery) ([]models.RestaurantCancellation, error) {
+	cte, params := analyticsOrders(query, false)
+	params = append(params, query.MinOrders, query.Limit)
+
+	queryStr := fmt.Sprintf(`%s
+		SELECT o."restaurantId", COALESCE(r."name", ''), COUNT(*),
+			COUNT(*) FILTER (WHERE o."status" = 'CANCELLED'),
+			COUNT(*) FILTER (WHERE o."status" = 'REJECTED')
+		FROM orders o
+		LEFT JOIN "Restaurant" r ON r."id" = o."restaurantId"
+		GROUP BY o."restaurantId", r."name"
+		HAVING COUNT(*) >= $%d
+		ORDER BY COUNT(*) FILTER (WHERE o."status" IN ('CANCELLED', 'REJECTED'))::float8 / COUNT(*) DESC,
+			COUNT(*) DESC, o."restaurantId"
+		LIMIT $%d
+	`, cte, len(params)-1, len(params))
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("취소/거절 비율 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	items := []models.RestaurantCancellation{}
+	for rows.Next() {
+		var item models.RestaurantCancellation
+		if err := rows.Scan(&item.RestaurantID, &item.Name, &item.Orders, &item.CancelledOrders, &item.RejectedOrders); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		item.CancellationRate = ratio(item.CancelledOrders, item.Orders)
+		item.RejectionRate = ratio(item.RejectedOrders, item.Orders)
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return items, nil
+}
+
+// GetDeliveryMix는 배달 유형별 주문 수와 GMV를 조회합니다. 비중은 기간 내 전체 주문 대비입니다.
+func (r *AnalyticsRepository) GetDeliveryMix(ctx context.Context, query dto.AnalyticsQuery) ([]models.DeliveryTypeShare, error) {
+	cte, params := analyticsOrders(query, true)
+
+	queryStr := fmt.Sprintf(`%s
+		SELECT COALESCE(d."type"::text, 'NONE') AS "type", COUNT(*) AS "orders",
+			COALESCE(SUM(o."total") FILTER (WHERE o.%s), 0)::bigint
+		FROM orders o
+		LEFT JOIN "OrderDelivery" d ON d."orderId" = o."id"
+		GROUP BY "type"
+		ORDER BY "orders" DESC, "type"
+	`, cte, validOrderCondition)
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("배달 유형 비중 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	items := []models.DeliveryTypeShare{}
+	total := 0
+	for rows.Next() {
+		var item models.DeliveryTypeShare
+		if err := rows.Scan(&item.Type, &item.Orders, &item.GMV); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		total += item.Orders
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	for i := range items {
+		items[i].Share = ratio(items[i].Orders, total)
+	}
+	return items, nil
+}
+
+// ratio는 소수점 넷째 자리까지 반올림한 비율을 반환합니다.
+func ratio(part, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(whole)*10000) / 10000
+}
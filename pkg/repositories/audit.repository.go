@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// AuditRepository는 감사 로그 데이터 액세스를 처리합니다.
+type AuditRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewAuditRepository는 새 AuditRepository 인스턴스를 생성합니다.
+func NewAuditRepository(dbPool *pgxpool.Pool) *AuditRepository {
+	return &AuditRepository{
+		dbPool: dbPool,
+	}
+}
+
+// Create는 감사 로그를 추가합니다. 변경과 같은 트랜잭션에서 호출해야 합니다.
+func (r *AuditRepository) Create(ctx context.Context, q Querier, entry *models.AuditLog) error {
+	query := `
+		INSERT INTO "AuditLog" (
+			"actorId", "actorRole", "action", "targetType", "targetId",
+			"before", "after", "reason", "ip", "userAgent", "requestId"
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING "id", "createdAt"
+	`
+
+	err := q.QueryRow(ctx, query,
+		entry.ActorID, nullableText(entry.ActorRole), entry.Action, entry.TargetType, entry.TargetID,
+		jsonbValue(entry.Before), jsonbValue(entry.After), entry.Reason,
+		nullableText(entry.IP), nullableText(entry.UserAgent), nullableText(entry.RequestID),
+	).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("감사 로그 저장 오류: %w", err)
+	}
+	return nil
+}
+
+// List는 필터와 커서(로그 ID 내림차순)로 감사 로그를 조회합니다.
+func (r *AuditRepository) List(ctx context.Context, query dto.AuditLogQuery) ([]models.AuditLog, error) {
+	// 쿼리 빌더 패턴 적용
+	whereClause := `WHERE 1 = 1`
+	params := []interface{}{}
+	paramIndex := 1
+
+	addFilter := func(condition string, value interface{}) {
+		whereClause += fmt.Sprintf(condition, paramIndex)
+		params = append(params, value)
+		paramIndex++
+	}
+
+	if query.ActorID != "" {
+		addFilter(` AND "actorId" = $%d`, query.ActorID)
+	}
+	if query.Action != "" {
+		addFilter(` AND "action" = $%d`, string(query.Action))
+	}
+	if query.TargetType != "" {
+		addFilter(` AND "targetType" = $%d`, string(query.TargetType))
+	}
+	if query.TargetID != "" {
+		addFilter(` AND "targetId" = $%d`, query.TargetID)
+	}
+	if query.From != nil {
+		addFilter(` AND "createdAt" >= $%d`, *query.From)
+	}
+	if query.To != nil {
+		addFilter(` AND "createdAt" < $%d`, *query.To)
+	}
+	if query.Cursor != nil {
+		addFilter(` AND "id" < $%d`, *query.Cursor)
+	}
+
+	queryStr := fmt.Sprintf(`
+		SELECT "id", "actorId", "actorRole", "action", "targetType", "targetId",
+			"before", "after", "reason", "ip", "userAgent", "requestId", "createdAt"
+		FROM "AuditLog"
+		%s
+		ORDER BY "id" DESC
+		LIMIT $%d
+	`, whereClause, paramIndex)
+	params = append(params, query.Limit)
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, fmt.Errorf("감사 로그 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	logs := []models.AuditLog{}
+	for rows.Next() {
+		var entry models.AuditLog
+		var actorRole, ip, userAgent, requestID pgtype.Text
+		var before, after pgtype.JSONB
+
+		err := rows.Scan(
+			&entry.ID, &entry.ActorID, &actorRole, &entry.Action, &entry.TargetType, &entry.TargetID,
+			&before, &after, &entry.Reason, &ip, &userAgent, &requestID, &entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+
+		entry.ActorRole = actorRole.String
+		entry.IP = ip.String
+		entry.UserAgent = userAgent.String
+		entry.RequestID = requestID.String
+		if before.Status == pgtype.Present {
+			entry.Before = before.Bytes
+		}
+		if after.Status == pgtype.Present {
+			entry.After = after.Bytes
+		}
+
+		logs = append(logs, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return logs, nil
+}
+
+// nullableText는 빈 문자열을 NULL로 저장하기 위한 값을 반환합니다.
+func nullableText(value string) pgtype.Text {
+	if value == "" {
+		return pgtype.Text{Status: pgtype.Null}
+	}
+	return pgtype.Text{String: value, Status: pgtype.Present}
+}
+
+// jsonbValue는 비어있는 JSON을 NULL로 저장하기 위한 값을 반환합니다.
+func jsonbValue(value []byte) pgtype.JSONB {
+	if len(value) == 0 {
+		return pgtype.JSONB{Status: pgtype.Null}
+	}
+	return pgtype.JSONB{Bytes: value, Status: pgtype.Present}
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"lambda-go/pkg/models"
+)
+
+// GetBusinessHours는 매장의 주간 영업 시간을 요일, 시작 시간 순으로 조회합니다.
+func (r *RestaurantRepository) GetBusinessHours(ctx context.Context, restaurantID string) ([]models.BusinessHour, error) {
+	return findBusinessHours(ctx, r.dbPool, restaurantID)
+}
+
+// GetBusinessSchedule은 매장의 주간 영업 시간, 정기 휴무일, 예외 날짜를 조회합니다.
+func (r *RestaurantRepository) GetBusinessSchedule(ctx context.Context, restaurantID string) (*models.BusinessSchedule, error) {
+	return r.FindBusinessSchedule(ctx, r.dbPool, restaurantID)
+}
+
+// FindBusinessSchedule은 주어진 트랜잭션 안에서 매장의 영업 일정을 조회합니다.
+func (r *RestaurantRepository) FindBusinessSchedule(ctx context.Context, q Querier, restaurantID string) (*models.BusinessSchedule, error) {
+	hours, err := findBusinessHours(ctx, q, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := findRestaurantHolidays(ctx, q, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions, err := findBusinessHourExceptions(ctx, q, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.BusinessSchedule{
+		RestaurantID: restaurantID,
+		Timezone:     models.SeoulLocation.String(),
+		Hours:        hours,
+		Holidays:     holidays,
+		Exceptions:   exceptions,
+	}, nil
+}
+
+// ReplaceBusinessSchedule은 매장의 영업 시간, 정기 휴무일, 예외 날짜를 모두 지우고 주어진 일정으로 다시 저장합니다.
+// 부분 교체를 막기 위해 반드시 트랜잭션 안에서 호출해야 하며, 저장된 ID를 schedule에 채웁니다.
+func (r *RestaurantRepository) ReplaceBusinessSchedule(ctx context.Context, q Querier, schedule *models.BusinessSchedule) error {
+	for _, table := range []string{"BusinessHour", "RestaurantHoliday", "BusinessHourException"} {
+		query := fmt.Sprintf(`DELETE FROM "%s" WHERE "restaurantId" = $1`, table)
+		if _, err := q.Exec(ctx, query, schedule.RestaurantID); err != nil {
+			return fmt.Errorf("기존 영업 일정 삭제 오류 (%s): %w", table, err)
+		}
+	}
+
+	for i := range schedule.Hours {
+		hour := &schedule.Hours[i]
+		hour.RestaurantID = schedule.RestaurantID
+		err := q.QueryRow(ctx, `
+			INSERT INTO "BusinessHour" ("restaurantId", "openTime", "closeTime", "dayOfWeek")
+			VALUES ($1, $2, $3, $4)
+			RETURNING "id"
+		`, hour.RestaurantID, hour.OpenTime, hour.CloseTime, hour.DayOfWeek).Scan(&hour.ID)
+		if err != nil {
+			return fmt.Errorf("영업 시간 저장 오류: %w", err)
+		}
+	}
+
+	for i := range schedule.Holidays {
+		holiday := &schedule.Holidays[i]
+		holiday.RestaurantID = schedule.RestaurantID
+		err := q.QueryRow(ctx, `
+			INSERT INTO "RestaurantHoliday" ("restaurantId", "dayOfWeek", "weekOfMonth")
+			VALUES ($1, $2, $3)
+			RETURNING "id"
+		`, holiday.RestaurantID, holiday.DayOfWeek, holiday.WeekOfMonth).Scan(&holiday.ID)
+		if err != nil {
+			return fmt.Errorf("정기 휴무일 저장 오류: %w", err)
+		}
+	}
+
+	for i := range schedule.Exceptions {
+		exception := &schedule.Exceptions[i]
+		exception.RestaurantID = schedule.RestaurantID
+		err := q.QueryRow(ctx, `
+			INSERT INTO "BusinessHourException" ("restaurantId", "date", "closed", "openTime", "closeTime", "note")
+			VALUES ($1, $2::date, $3, $4, $5, $6)
+			RETURNING "id"
+		`, exception.RestaurantID, exception.Date, exception.Closed, exception.OpenTime, exception.CloseTime, exception.Note).Scan(&exception.ID)
+		if err != nil {
+			return fmt.Errorf("예외 날짜 저장 오류: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func findBusinessHours(ctx context.Context, q Querier, restaurantID string) ([]models.BusinessHour, error) {
+	query := `
+		SELECT "id", "restaurantId", "openTime", "closeTime", "dayOfWeek"
+		FROM "BusinessHour"
+		WHERE "restaurantId" = $1
+		ORDER BY array_position(ARRAY['MON','TUE','WED','THU','FRI','SAT','SUN'], "dayOfWeek"::text), "openTime"
+	`
+
+	rows, err := q.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("영업 시간 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.BusinessHour{}
+	for rows.Next() {
+		var hour models.BusinessHour
+		if err := rows.Scan(&hour.ID, &hour.RestaurantID, &hour.OpenTime, &hour.CloseTime, &hour.DayOfWeek); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, hour)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+func findRestaurantHolidays(ctx context.Context, q Querier, restaurantID string) ([]models.RestaurantHoliday, error) {
+	query := `
+		SELECT "id", "restaurantId", "dayOfWeek", "weekOfMonth"
+		FROM "RestaurantHoliday"
+		WHERE "restaurantId" = $1
+		ORDER BY array_position(ARRAY['MON','TUE','WED','THU','FRI','SAT','SUN'], "dayOfWeek"), "weekOfMonth" NULLS FIRST
+	`
+
+	rows, err := q.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("정기 휴무일 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.RestaurantHoliday{}
+	for rows.Next() {
+		var holiday models.RestaurantHoliday
+		if err := rows.Scan(&holiday.ID, &holiday.RestaurantID, &holiday.DayOfWeek, &holiday.WeekOfMonth); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, holiday)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+func findBusinessHourExceptions(ctx context.Context, q Querier, restaurantID string) ([]models.BusinessHourException, error) {
+	query := `
+		SELECT "id", "restaurantId", "date"::text, "closed", "openTime", "closeTime", "note"
+		FROM "BusinessHourException"
+		WHERE "restaurantId" = $1
+		ORDER BY "date"
+	`
+
+	rows, err := q.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("예외 날짜 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.BusinessHourException{}
+	for rows.Next() {
+		var exception models.BusinessHourException
+		if err := rows.Scan(&exception.ID, &exception.RestaurantID, &exception.Date, &exception.Closed, &exception.OpenTime, &exception.CloseTime, &exception.Note); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, exception)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const deliveryColumns = `d."id", d."orderId", d."status", d."type", d."deliveryInfo", d."estimatedTime", d."createdAt", d."updatedAt"`
+
+// deliveryExpectedAt은 배달 정보 생성 시각에 예상 시간(분)을 더한 예상 도착 시각입니다.
+const deliveryExpectedAt = `(d."createdAt" + d."estimatedTime" * INTERVAL '1 minute')`
+
+// scanOrderDelivery는 deliveryColumns 순서로 조회한 행을 주문 배달 모델로 변환합니다.
+// 배달 정보는 배달 유형에 맞게 디코딩하며, 저장된 값이 유형에 맞지 않으면 deliveryInfoError에 검증 에러를 담습니다.
+func scanOrderDelivery(row pgx.Row, extra ...interface{}) (*models.OrderDelivery, error) {
+	var delivery models.OrderDelivery
+	var info []byte
+
+	dest := []interface{}{
+		&delivery.ID, &delivery.OrderID, &delivery.Status, &delivery.Type,
+		&info, &delivery.EstimatedTime, &delivery.CreatedAt, &delivery.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	decoded, err := models.DecodeDeliveryInfo(delivery.Type, info)
+	if err != nil {
+		message := err.Error()
+		delivery.DeliveryInfoError = &message
+	} else {
+		delivery.DeliveryInfo = decoded
+	}
+	return &delivery, nil
+}
+
+// GetOrderDelivery는 주문 배달 정보를 조회합니다. 배달 정보가 없으면 nil을 반환합니다.
+func (r *OrderRepository) GetOrderDelivery(ctx context.Context, orderID string) (*models.OrderDelivery, error) {
+	return findOrderDelivery(ctx, r.dbPool, orderID, "")
+}
+
+// LockOrderDelivery는 트랜잭션 안에서 주문 배달 행을 잠그고 조회합니다 (SELECT ... FOR UPDATE).
+func (r *OrderRepository) LockOrderDelivery(ctx context.Context, q Querier, orderID string) (*models.OrderDelivery, error) {
+	delivery, err := findOrderDelivery(ctx, q, orderID, "FOR UPDATE")
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, fmt.Errorf("주문 ID %s의 배달 정보: %w", orderID, ErrNotFound)
+	}
+	return delivery, nil
+}
+
+func findOrderDelivery(ctx context.Context, q Querier, orderID string, lockClause string) (*models.OrderDelivery, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM "OrderDelivery" d
+		WHERE d."orderId" = $1
+		%s
+	`, deliveryColumns, lockClause)
+
+	delivery, err := scanOrderDelivery(q.QueryRow(ctx, query, orderID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("주문 배달 정보 조회 오류: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// GetInFlightDeliveries는 배달 중(DELIVERING)인 주문 배달을 예상 도착 시각이 이른 순으로 조회합니다.
+// 예상 시간이 없는 배달은 마지막에 오래된 순으로 정렬됩니다.
+func (r *OrderRepository) GetInFlightDeliveries(ctx context.Context, query dto.DeliveryQuery, now time.Time) ([]models.InFlightDelivery, int, error) {
+	whereClause := `WHERE d."status" = $1`
+	params := []interface{}{models.DELIVERING}
+	paramIndex := 2
+
+	if query.RestaurantID != nil {
+		whereClause += fmt.Sprintf(` AND o."restaurantId" = $%d`, paramIndex)
+		params = append(params, *query.RestaurantID)
+		paramIndex++
+	}
+
+	if query.Type != nil {
+		whereClause += fmt.Sprintf(` AND d."type" = $%d`, paramIndex)
+		params = append(params, string(*query.Type))
+		paramIndex++
+	}
+
+	if query.Overdue {
+		whereClause += fmt.Sprintf(` AND d."estimatedTime" IS NOT NULL AND %s < $%d`, deliveryExpectedAt, paramIndex)
+		params = append(params, now)
+		paramIndex++
+	}
+
+	// 전체 개수 조회
+	var total int
+	countQuery := `SELECT COUNT(*) FROM "OrderDelivery" d JOIN "Order" o ON o."id" = d."orderId" ` + whereClause
+	if err := r.dbPool.QueryRow(ctx, countQuery, params...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("배달 개수 조회 오류: %w", err)
+	}
+
+	// 배달 목록 조회
+	queryStr := fmt.Sprintf(`
+		SELECT %s, o."restaurantId", o."customerId", o."status"
+		FROM "OrderDelivery" d
+		JOIN "Order" o ON o."id" = d."orderId"
+		%s
+		ORDER BY %s ASC NULLS LAST, d."createdAt", d."id"
+		LIMIT $%d OFFSET $%d
+	`, deliveryColumns, whereClause, deliveryExpectedAt, paramIndex, paramIndex+1)
+
+	params = append(params, query.PageSize, (query.Page-1)*query.PageSize)
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("배달 목록 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.InFlightDelivery{}
+	for rows.Next() {
+		var order models.Order
+		delivery, err := scanOrderDelivery(rows, &order.RestaurantID, &order.CustomerID, &order.Status)
+		if err != nil {
+			return nil, 0, fmt.Errorf("배달 데이터 스캔 오류: %w", err)
+		}
+		result = append(result, models.NewInFlightDelivery(*delivery, order, now))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("배달 목록 조회 오류: %w", err)
+	}
+
+	return result, total, nil
+}
+
+// UpdateDeliveryStatus는 주문 배달 상태를 변경합니다.
+// 배달 상태가 from이 아니면 다른 요청이 먼저 변경한 것으로 보고 ErrConflict를 반환합니다.
+func (r *OrderRepository) UpdateDeliveryStatus(ctx context.Context, q Querier, deliveryID string, from, to models.DeliveryStatus) (time.Time, error) {
+	query := `
+		UPDATE "OrderDelivery"
+		SET "status" = $1, "updatedAt" = $2
+		WHERE "id" = $3 AND "status" = $4
+	`
+
+	now := time.Now()
+	tag, err := q.Exec(ctx, query, to, now, deliveryID, from)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("배달 상태 변경 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return time.Time{}, fmt.Errorf("배달 ID %s: %w", deliveryID, ErrConflict)
+	}
+	return now, nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"lambda-go/pkg/models"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// EventRepository는 도메인 이벤트 아웃박스 데이터 액세스를 처리합니다.
+type EventRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewEventRepository는 새 EventRepository 인스턴스를 생성합니다.
+func NewEventRepository(dbPool *pgxpool.Pool) *EventRepository {
+	return &EventRepository{
+		dbPool: dbPool,
+	}
+}
+
+// Append는 이벤트를 아웃박스에 추가합니다. 이벤트의 원인이 된 변경과 같은 트랜잭션에서 호출해야 합니다.
+func (r *EventRepository) Append(ctx context.Context, q Querier, event *models.DomainEvent) error {
+	query := `
+		INSERT INTO "DomainEventOutbox" (
+			"eventId", "eventType", "aggregateType", "aggregateId", "actorId", "data", "occurredAt"
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := q.Exec(ctx, query,
+		event.ID, event.Type, event.AggregateType, event.AggregateID, nullableText(event.ActorID),
+		jsonbValue(event.Data), event.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("이벤트 아웃박스 저장 오류: %w", err)
+	}
+	return nil
+}
+
+// LeaseDue는 발행 시간이 된 이벤트를 기록 순서대로 최대 limit건 가져오고, lease 동안 다른 릴레이가 가져가지 않도록 다음 시도 시간을 미룹니다.
+// 같은 대상에 앞선 PENDING 이벤트(재시도 대기 또는 다른 릴레이가 lease 중)가 있으면 가져오지 않으므로, 대상별로 한 번에 하나씩 기록 순서대로 발행됩니다.
+// 릴레이가 발행 결과를 기록하지 못하고 종료되면 lease가 끝난 뒤 다시 발행되므로 전달은 최소 한 번(at-least-once) 보장됩니다.
+func (r *EventRepository) LeaseDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.DomainEventOutbox, error) {
+	query := `
+		UPDATE "DomainEventOutbox"
+		SET "nextAttemptAt" = $2
+		WHERE "id" IN (
+			SELECT "id"
+			FROM "DomainEventOutbox" o
+			WHERE o."status" = 'PENDING' AND o."nextAttemptAt" <= $1
+				AND NOT EXISTS (
+					SELECT 1
+					FROM "DomainEventOutbox" prev
+					WHERE prev."aggregateType" = o."aggregateType"
+						AND prev."aggregateId" = o."aggregateId"
+						AND prev."status" = 'PENDING'
+						AND prev."id" < o."id"
+				)
+			ORDER BY o."id"
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING "id", "eventId", "eventType", "aggregateType", "aggregateId", "actorId", "data", "occurredAt",
+			"status", "attempts", "lastError", "nextAttemptAt", "publishedAt"
+	`
+
+	rows, err := r.dbPool.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("발행 대기 이벤트 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.DomainEventOutbox{}
+	for rows.Next() {
+		var entry models.DomainEventOutbox
+		var actorID pgtype.Text
+		var data pgtype.JSONB
+
+		err := rows.Scan(
+			&entry.Seq, &entry.Event.ID, &entry.Event.Type, &entry.Event.AggregateType, &entry.Event.AggregateID,
+			&actorID, &data, &entry.Event.OccurredAt,
+			&entry.Status, &entry.Attempts, &entry.LastError, &entry.NextAttemptAt, &entry.PublishedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+
+		entry.Event.ActorID = actorID.String
+		if data.Status == pgtype.Present {
+			entry.Event.Data = data.Bytes
+		}
+		result = append(result, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	// UPDATE ... RETURNING은 순서를 보장하지 않으므로 기록 순서로 정렬
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Seq < result[j].Seq
+	})
+	return result, nil
+}
+
+// MarkPublished는 이벤트를 발행 완료로 기록합니다.
+func (r *EventRepository) MarkPublished(ctx context.Context, seq int64, attempts int) error {
+	query := `
+		UPDATE "DomainEventOutbox"
+		SET "status" = 'PUBLISHED', "attempts" = $2, "lastError" = NULL, "publishedAt" = $3
+		WHERE "id" = $1
+	`
+
+	if _, err := r.dbPool.Exec(ctx, query, seq, attempts, time.Now()); err != nil {
+		return fmt.Errorf("이벤트 발행 완료 기록 오류: %w", err)
+	}
+	return nil
+}
+
+// MarkRetry는 발행 실패를 기록하고 nextAttemptAt에 다시 시도하도록 합니다.
+func (r *EventRepository) MarkRetry(ctx context.Context, seq int64, attempts int, nextAttemptAt time.Time, lastError string) error {
+	query := `
+		UPDATE "DomainEventOutbox"
+		SET "attempts" = $2, "nextAttemptAt" = $3, "lastError" = $4
+		WHERE "id" = $1
+	`
+
+	if _, err := r.dbPool.Exec(ctx, query, seq, attempts, nextAttemptAt, lastError); err != nil {
+		return fmt.Errorf("이벤트 재시도 기록 오류: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed는 최대 시도 횟수를 넘긴 이벤트를 실패로 기록합니다.
+func (r *EventRepository) MarkFailed(ctx context.Context, seq int64, attempts int, lastError string) error {
+	query := `
+		UPDATE "DomainEventOutbox"
+		SET "status" = 'FAILED', "attempts" = $2, "lastError" = $3
+		WHERE "id" = $1
+	`
+
+	if _, err := r.dbPool.Exec(ctx, query, seq, attempts, lastError); err != nil {
+		return fmt.Errorf("이벤트 실패 기록 오류: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"lambda-go/pkg/models"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// LicenseRepository는 사업자등록번호 상태 조회 결과 데이터 액세스를 처리합니다.
+type LicenseRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewLicenseRepository는 새 LicenseRepository 인스턴스를 생성합니다.
+func NewLicenseRepository(dbPool *pgxpool.Pool) *LicenseRepository {
+	return &LicenseRepository{
+		dbPool: dbPool,
+	}
+}
+
+// FindByNumbers는 주어진 사업자등록번호들의 최근 조회 결과를 번호별로 반환합니다.
+// 조회 결과가 없는 번호는 결과 맵에 포함되지 않습니다.
+func (r *LicenseRepository) FindByNumbers(ctx context.Context, numbers []string) (map[string]*models.BusinessLicenseVerification, error) {
+	return findLicenseVerifications(ctx, r.dbPool, numbers)
+}
+
+// FindByNumber는 주어진 트랜잭션 안에서 사업자등록번호의 최근 조회 결과를 조회합니다.
+// 조회 결과가 없으면 nil을 반환합니다.
+func (r *LicenseRepository) FindByNumber(ctx context.Context, q Querier, number string) (*models.BusinessLicenseVerification, error) {
+	result, err := findLicenseVerifications(ctx, q, []string{number})
+	if err != nil {
+		return nil, err
+	}
+	return result[number], nil
+}
+
+func findLicenseVerifications(ctx context.Context, q Querier, numbers []string) (map[string]*models.BusinessLicenseVerification, error) {
+	query := `
+		SELECT "licenseNumber", "status", "provider", "taxType", "closedAt", "message", "checkedAt"
+		FROM "BusinessLicenseVerification"
+		WHERE "licenseNumber" = ANY($1)
+	`
+
+	rows, err := q.Query(ctx, query, numbers)
+	if err != nil {
+		return nil, fmt.Errorf("사업자등록번호 조회 결과 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]*models.BusinessLicenseVerification, len(numbers))
+	for rows.Next() {
+		var v models.BusinessLicenseVerification
+		if err := rows.Scan(&v.LicenseNumber, &v.Status, &v.Provider, &v.TaxType, &v.ClosedAt, &v.Message, &v.CheckedAt); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result[v.LicenseNumber] = &v
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+// Upsert는 사업자등록번호 조회 결과를 저장합니다. 이전 결과가 있으면 덮어씁니다.
+func (r *LicenseRepository) Upsert(ctx context.Context, v *models.BusinessLicenseVerification) error {
+	query := `
+		INSERT INTO "BusinessLicenseVerification"
+			("licenseNumber", "status", "provider", "taxType", "closedAt", "message", "checkedAt")
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT ("licenseNumber") DO UPDATE SET
+			"status" = EXCLUDED."status",
+			"provider" = EXCLUDED."provider",
+			"taxType" = EXCLUDED."taxType",
+			"closedAt" = EXCLUDED."closedAt",
+			"message" = EXCLUDED."message",
+			"checkedAt" = EXCLUDED."checkedAt"
+	`
+
+	_, err := r.dbPool.Exec(ctx, query, v.LicenseNumber, v.Status, v.Provider, v.TaxType, v.ClosedAt, v.Message, v.CheckedAt)
+	if err != nil {
+		return fmt.Errorf("사업자등록번호 조회 결과 저장 오류: %w", err)
+	}
+	return nil
+}
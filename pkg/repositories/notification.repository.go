@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// NotificationRepository는 알림 아웃박스 데이터 액세스를 처리합니다.
+type NotificationRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewNotificationRepository는 새 NotificationRepository 인스턴스를 생성합니다.
+func NewNotificationRepository(dbPool *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{
+		dbPool: dbPool,
+	}
+}
+
+// Enqueue는 알림을 아웃박스에 추가합니다. 알림의 원인이 된 변경과 같은 트랜잭션에서 호출해야 합니다.
+func (r *NotificationRepository) Enqueue(ctx context.Context, q Querier, entries []*models.NotificationOutbox) error {
+	query := `
+		INSERT INTO "NotificationOutbox" ("userId", "channel", "template", "locale", "payload")
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING "id", "status", "nextAttemptAt", "createdAt"
+	`
+
+	for _, entry := range entries {
+		err := q.QueryRow(ctx, query,
+			entry.UserID, entry.Channel, entry.Template, entry.Locale, jsonbValue(entry.Payload),
+		).Scan(&entry.ID, &entry.Status, &entry.NextAttemptAt, &entry.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("알림 아웃박스 저장 오류: %w", err)
+		}
+	}
+	return nil
+}
+
+// LeaseDue는 발송 시간이 된 알림을 최대 limit건 가져오고, lease 동안 다른 워커가 가져가지 않도록 다음 시도 시간을 미룹니다.
+// 워커가 발송 결과를 기록하지 못하고 종료되면 lease가 끝난 뒤 다시 발송 대상이 됩니다.
+func (r *NotificationRepository) LeaseDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.NotificationOutbox, error) {
+	query := `
+		UPDATE "NotificationOutbox"
+		SET "nextAttemptAt" = $2
+		WHERE "id" IN (
+			SELECT "id"
+			FROM "NotificationOutbox"
+			WHERE "status" = 'PENDING' AND "nextAttemptAt" <= $1
+			ORDER BY "nextAttemptAt", "id"
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING "id", "userId", "channel", "template", "locale", "payload", "status",
+			"attempts", "lastError", "nextAttemptAt", "createdAt", "sentAt"
+	`
+
+	rows, err := r.dbPool.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("발송 대기 알림 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.NotificationOutbox{}
+	for rows.Next() {
+		var entry models.NotificationOutbox
+		var payload pgtype.JSONB
+
+		err := rows.Scan(
+			&entry.ID, &entry.UserID, &entry.Channel, &entry.Template, &entry.Locale, &payload, &entry.Status,
+			&entry.Attempts, &entry.LastError, &entry.NextAttemptAt, &entry.CreatedAt, &entry.SentAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+
+		if payload.Status == pgtype.Present {
+			entry.Payload = payload.Bytes
+		}
+		result = append(result, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+// MarkSent는 알림을 발송 완료로 기록합니다.
+func (r *NotificationRepository) MarkSent(ctx context.Context, id int64, attempts int) error {
+	query := `
+		UPDATE "NotificationOutbox"
+		SET "status" = 'SENT', "attempts" = $2, "lastError" = NULL, "sentAt" = $3
+		WHERE "id" = $1
+	`
+
+	if _, err := r.dbPool.Exec(ctx, query, id, attempts, time.Now()); err != nil {
+		return fmt.Errorf("알림 발송 완료 기록 오류: %w", err)
+	}
+	return nil
+}
+
+// MarkRetry는 발송 실패를 기록하고 nextAttemptAt에 다시 시도하도록 합니다.
+func (r *NotificationRepository) MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastError string) error {
+	query := `
+		UPDATE "NotificationOutbox"
+		SET "attempts" = $2, "nextAttemptAt" = $3, "lastError" = $4
+		WHERE "id" = $1
+	`
+
+	if _, err := r.dbPool.Exec(ctx, query, id, attempts, nextAttemptAt, lastError); err != nil {
+		return fmt.Errorf("알림 재시도 기록 오류: %w", err)
+	}
+	return nil
+}
+
+// MarkFinished는 더 이상 발송을 시도하지 않을 알림의 최종 상태(FAILED, SKIPPED)를 기록합니다.
+func (r *NotificationRepository) MarkFinished(ctx context.Context, id int64, status models.NotificationStatus, attempts int, lastError string) error {
+	query := `
+		UPDATE "NotificationOutbox"
+		SET "status" = $2, "attempts" = $3, "lastError" = $4
+		WHERE "id" = $1
+	`
+
+	if _, err := r.dbPool.Exec(ctx, query, id, status, attempts, nullableText(lastError)); err != nil {
+		return fmt.Errorf("알림 상태 기록 오류: %w", err)
+	}
+	return nil
+}
+
+// GetRecipient는 알림 수신자의 연락처와 매장 정보를 조회합니다.
+func (r *NotificationRepository) GetRecipient(ctx context.Context, userID, restaurantID string) (*models.NotificationRecipient, error) {
+	query := `
+		SELECT u."id", u."name", u."email", rs."name", rs."phoneNumber"
+		FROM "User" u
+		LEFT JOIN "Restaurant" rs ON rs."id" = $2
+		WHERE u."id" = $1
+	`
+
+	var recipient models.NotificationRecipient
+	var name, email, restaurantName, phoneNumber pgtype.Text
+
+	err := r.dbPool.QueryRow(ctx, query, userID, restaurantID).Scan(
+		&recipient.UserID, &name, &email, &restaurantName, &phoneNumber,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("사용자 ID %s: %w", userID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("알림 수신자 조회 오류: %w", err)
+	}
+
+	recipient.Name = name.String
+	recipient.Email = email.String
+	recipient.RestaurantName = restaurantName.String
+	recipient.PhoneNumber = phoneNumber.String
+	return &recipient, nil
+}
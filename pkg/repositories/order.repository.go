@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// OrderRepository는 주문, 주문 메뉴, 주문 배달 정보 데이터 액세스를 처리합니다.
+type OrderRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewOrderRepository는 새 OrderRepository 인스턴스를 생성합니다.
+func NewOrderRepository(dbPool *pgxpool.Pool) *OrderRepository {
+	return &OrderRepository{
+		dbPool: dbPool,
+	}
+}
+
+// orderColumns는 주문 조회 컬럼입니다.
+// 합계는 주문 메뉴의 현재 메뉴 가격 × 수량으로 계산합니다. OrderMenu에 주문 시점 가격이 저장되지 않으므로
+// 주문 후 메뉴 가격이 바뀌었거나 메뉴가 삭제되면 실제 결제 금액과 다를 수 있는 추정치입니다.
+const orderColumns = `o."id", o."restaurantId", o."customerId", o."status",
+	o."cancelReason", o."cancelledBy", o."cancelledAt", o."createdAt", o."updatedAt",
+	COALESCE((
+		SELECT SUM(m."price" * om."quantity")
+		FROM "OrderMenu" om
+		JOIN "RestaurantMenu" m ON m."id" = om."menuId"
+		WHERE om."orderId" = o."id"
+	), 0)`
+
+// scanOrder는 orderColumns 순서로 조회한 행을 주문 모델로 변환합니다.
+func scanOrder(row pgx.Row) (*models.Order, error) {
+	var order models.Order
+	err := row.Scan(
+		&order.ID, &order.RestaurantID, &order.CustomerID, &order.Status,
+		&order.CancelReason, &order.CancelledBy, &order.CancelledAt, &order.CreatedAt, &order.UpdatedAt,
+		&order.TotalPrice,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// orderFilter는 주문 목록 검색 조건을 WHERE 절과 파라미터로 변환합니다.
+func orderFilter(query dto.OrderQuery) (string, []interface{}) {
+	whereClause := `WHERE TRUE`
+	params := []interface{}{}
+
+	addFilter := func(format string, value interface{}) {
+		params = append(params, value)
+		whereClause += fmt.Sprintf(format, len(params))
+	}
+
+	if query.RestaurantID != nil {
+		addFilter(` AND o."restaurantId" = $%d`, *query.RestaurantID)
+	}
+	if query.CustomerID != nil {
+		addFilter(` AND o."customerId" = $%d`, *query.CustomerID)
+	}
+	if query.Status != nil {
+		addFilter(` AND o."status" = $%d`, string(*query.Status))
+	}
+	if query.From != nil {
+		addFilter(` AND o."createdAt" >= $%d`, *query.From)
+	}
+	if query.To != nil {
+		addFilter(` AND o."createdAt" < $%d`, *query.To)
+	}
+
+	return whereClause, params
+}
+
+// scanOrders는 orderColumns 순서로 조회한 행을 주문 목록으로 변환합니다.
+func scanOrders(rows pgx.Rows) ([]models.Order, error) {
+	defer rows.Close()
+
+	result := []models.Order{}
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("주문 데이터 스캔 오류: %w", err)
+		}
+		result = append(result, *order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("주문 목록 조회 오류: %w", err)
+	}
+	return result, nil
+}
+
+// GetOrders는 검색 조건에 맞는 주문 목록을 최신 주문 순으로 조회합니다.
+func (r *OrderRepository) GetOrders(ctx context.Context, query dto.OrderQuery) ([]models.Order, int, error) {
+	whereClause, params := orderFilter(query)
+	paramIndex := len(params) + 1
+
+	// 전체 개수 조회
+	var total int
+	countQuery := `SELECT COUNT(*) FROM "Order" o ` + whereClause
+	if err := r.dbPool.QueryRow(ctx, countQuery, params...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("주문 개수 조회 오류: %w", err)
+	}
+
+	// 주문 목록 조회
+	queryStr := fmt.Sprintf(`
+		SELECT %s
+		FROM "Order" o
+		%s
+		ORDER BY o."createdAt" DESC, o."id"
+		LIMIT $%d OFFSET $%d
+	`, orderColumns, whereClause, paramIndex, paramIndex+1)
+
+	params = append(params, query.PageSize, (query.Page-1)*query.PageSize)
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("주문 목록 조회 오류: %w", err)
+	}
+
+	result, err := scanOrders(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
+// CountOrdersForExport는 q(내보내기 스냅샷 트랜잭션)에서 검색 조건에 맞는 주문 수를 조회합니다.
+func (r *OrderRepository) CountOrdersForExport(ctx context.Context, q Querier, query dto.OrderQuery) (int, error) {
+	whereClause, params := orderFilter(query)
+
+	var total int
+	if err := q.QueryRow(ctx, `SELECT COUNT(*) FROM "Order" o `+whereClause, params...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("주문 개수 조회 오류: %w", err)
+	}
+	return total, nil
+}
+
+// GetOrdersForExport는 q(내보내기 스냅샷 트랜잭션)에서 afterID보다 큰 ID의 주문을 ID 순으로 최대 limit건 조회합니다.
+// 첫 페이지는 afterID ""로 조회합니다.
+func (r *OrderRepository) GetOrdersForExport(ctx context.Context, q Querier, query dto.OrderQuery, afterID string, limit int) ([]models.Order, error) {
+	whereClause, params := orderFilter(query)
+	paramIndex := len(params) + 1
+
+	queryStr := fmt.Sprintf(`
+		SELECT %s
+		FROM "Order" o
+		%s AND o."id" > $%d
+		ORDER BY o."id"
+		LIMIT $%d
+	`, orderColumns, whereClause, paramIndex, paramIndex+1)
+
+	rows, err := q.Query(ctx, queryStr, append(params, afterID, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("주문 목록 조회 오류: %w", err)
+	}
+	return scanOrders(rows)
+}
+
+// GetOrderByID는 주문을 조회합니다.
+func (r *OrderRepository) GetOrderByID(ctx context.Context, orderID string) (*models.Order, error) {
+	return findOrder(ctx, r.dbPool, orderID, "")
+}
+
+// LockOrder는 트랜잭션 안에서 주문 행을 잠그고 조회합니다 (SELECT ... FOR UPDATE).
+func (r *OrderRepository) LockOrder(ctx context.Context, q Querier, orderID string) (*models.Order, error) {
+	return findOrder(ctx, q, orderID, "FOR UPDATE OF o")
+}
+
+func findOrder(ctx context.Context, q Querier, orderID string, lockClause string) (*models.Order, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM "Order" o
+		WHERE o."id" = $1
+		%s
+	`, orderColumns, lockClause)
+
+	order, err := scanOrder(q.QueryRow(ctx, query, orderID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("주문 ID %s: %w", orderID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("주문 조회 오류: %w", err)
+	}
+
+	return order, nil
+}
+
+// GetOrderMenus는 주문 메뉴를 메뉴 정보, 소계와 함께 조회합니다. 삭제된 메뉴는 메뉴 정보 없이 반환합니다.
+func (r *OrderRepository) GetOrderMenus(ctx context.Context, orderID string) ([]models.OrderMenu, error) {
+	query := `
+		SELECT om."orderId", om."menuId", om."quantity",
+			m."id", m."restaurantId", m."name", m."price", m."description", m."imageUrl", m."hidden", m."imageHidden"
+		FROM "OrderMenu" om
+		LEFT JOIN "RestaurantMenu" m ON m."id" = om."menuId"
+		WHERE om."orderId" = $1
+		ORDER BY m."name", om."menuId"
+	`
+
+	rows, err := r.dbPool.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("주문 메뉴 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.OrderMenu{}
+	for rows.Next() {
+		var line models.OrderMenu
+		var menuID, restaurantID, name *string
+		var price *int
+		var hidden, imageHidden *bool
+		menu := models.RestaurantMenu{}
+
+		err := rows.Scan(
+			&line.OrderID, &line.MenuID, &line.Quantity,
+			&menuID, &restaurantID, &name, &price, &menu.Description, &menu.ImageURL, &hidden, &imageHidden,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("주문 메뉴 데이터 스캔 오류: %w", err)
+		}
+
+		if menuID != nil {
+			menu.ID = *menuID
+			menu.RestaurantID = *restaurantID
+			menu.Name = *name
+			menu.Price = *price
+			menu.Hidden = *hidden
+			menu.ImageHidden = *imageHidden
+			line.Menu = &menu
+			line.Subtotal = menu.Price * line.Quantity
+		}
+		result = append(result, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("주문 메뉴 조회 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetCustomer는 주문 고객 정보를 조회합니다. 탈퇴 등으로 고객이 없으면 nil을 반환합니다.
+func (r *OrderRepository) GetCustomer(ctx context.Context, customerID string) (*models.User, error) {
+	query := `
+		SELECT "id", "email", "name"
+		FROM "User"
+		WHERE "id" = $1
+	`
+
+	var user models.User
+	err := r.dbPool.QueryRow(ctx, query, customerID).Scan(&user.ID, &user.Email, &user.Name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("고객 조회 오류: %w", err)
+	}
+
+	return &user, nil
+}
+
+// CancelOrder는 주문을 취소 상태로 바꾸고 취소 사유와 처리자를 기록합니다.
+// 주문 상태가 from이 아니면 다른 요청이 먼저 변경한 것으로 보고 ErrConflict를 반환합니다.
+func (r *OrderRepository) CancelOrder(ctx context.Context, q Querier, orderID string, from models.OrderStatus, reason, cancelledBy string) (time.Time, error) {
+	query := `
+		UPDATE "Order"
+		SET "status" = $1, "cancelReason" = $2, "cancelledBy" = $3, "cancelledAt" = $4, "updatedAt" = $4
+		WHERE "id" = $5 AND "status" = $6
+	`
+
+	now := time.Now()
+	tag, err := q.Exec(ctx, query, models.ORDER_CANCELLED, reason, cancelledBy, now, orderID, from)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("주문 취소 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return time.Time{}, fmt.Errorf("주문 ID %s: %w", orderID, ErrConflict)
+	}
+	return now, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lambda-go/pkg/models"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// RejectReasonRepository는 거절 사유 코드 데이터 액세스를 처리합니다.
+type RejectReasonRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewRejectReasonRepository는 새 RejectReasonRepository 인스턴스를 생성합니다.
+func NewRejectReasonRepository(dbPool *pgxpool.Pool) *RejectReasonRepository {
+	return &RejectReasonRepository{
+		dbPool: dbPool,
+	}
+}
+
+const rejectReasonColumns = `"code", "titleKo", "titleEn", "templateKo", "templateEn", "active", "sortOrder", "createdAt", "updatedAt"`
+
+// List는 거절 사유 코드 목록을 정렬 순서대로 조회합니다.
+// activeOnly가 true면 활성 코드만 조회합니다.
+func (r *RejectReasonRepository) List(ctx context.Context, activeOnly bool) ([]models.RejectReasonCode, error) {
+	query := `SELECT ` + rejectReasonColumns + ` FROM "RejectReasonCode"`
+	if activeOnly {
+		query += ` WHERE "active"`
+	}
+	query += ` ORDER BY "sortOrder", "code"`
+
+	return queryRejectReasons(ctx, r.dbPool, query)
+}
+
+// FindActiveByCodes는 주어진 코드 중 활성 상태인 거절 사유 코드를 조회합니다.
+func (r *RejectReasonRepository) FindActiveByCodes(ctx context.Context, codes []string) ([]models.RejectReasonCode, error) {
+	query := `SELECT ` + rejectReasonColumns + `
+		FROM "RejectReasonCode"
+		WHERE "active" AND "code" = ANY($1)
+		ORDER BY "sortOrder", "code"`
+
+	return queryRejectReasons(ctx, r.dbPool, query, codes)
+}
+
+// FindByCodes는 활성 여부와 관계없이 주어진 거절 사유 코드를 조회합니다.
+// 이미 처리된 요청의 사유를 다시 표시할 때 사용합니다.
+func (r *RejectReasonRepository) FindByCodes(ctx context.Context, codes []string) ([]models.RejectReasonCode, error) {
+	query := `SELECT ` + rejectReasonColumns + `
+		FROM "RejectReasonCode"
+		WHERE "code" = ANY($1)
+		ORDER BY "sortOrder", "code"`
+
+	return queryRejectReasons(ctx, r.dbPool, query, codes)
+}
+
+// LockByCode는 트랜잭션 안에서 거절 사유 코드 행을 잠그고 조회합니다 (SELECT ... FOR UPDATE).
+func (r *RejectReasonRepository) LockByCode(ctx context.Context, q Querier, code string) (*models.RejectReasonCode, error) {
+	query := `SELECT ` + rejectReasonColumns + ` FROM "RejectReasonCode" WHERE "code" = $1 FOR UPDATE`
+
+	var item models.RejectReasonCode
+	err := q.QueryRow(ctx, query, code).Scan(
+		&item.Code, &item.TitleKo, &item.TitleEn, &item.TemplateKo, &item.TemplateEn,
+		&item.Active, &item.SortOrder, &item.CreatedAt, &item.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("거절 사유 코드 %s: %w", code, ErrNotFound)
+		}
+		return nil, fmt.Errorf("거절 사유 코드 조회 오류: %w", err)
+	}
+
+	return &item, nil
+}
+
+// Create는 거절 사유 코드를 추가합니다. 같은 코드가 이미 있으면 ErrConflict를 반환합니다.
+func (r *RejectReasonRepository) Create(ctx context.Context, q Querier, item *models.RejectReasonCode) error {
+	query := `
+		INSERT INTO "RejectReasonCode" ("code", "titleKo", "titleEn", "templateKo", "templateEn", "active", "sortOrder")
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT ("code") DO NOTHING
+		RETURNING "createdAt", "updatedAt"
+	`
+
+	err := q.QueryRow(ctx, query,
+		item.Code, item.TitleKo, item.TitleEn, item.TemplateKo, item.TemplateEn, item.Active, item.SortOrder,
+	).Scan(&item.CreatedAt, &item.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("거절 사유 코드 %s: %w", item.Code, ErrConflict)
+		}
+		return fmt.Errorf("거절 사유 코드 저장 오류: %w", err)
+	}
+	return nil
+}
+
+// Update는 거절 사유 코드의 내용을 변경합니다.
+func (r *RejectReasonRepository) Update(ctx context.Context, q Querier, item *models.RejectReasonCode) error {
+	query := `
+		UPDATE "RejectReasonCode"
+		SET "titleKo" = $1, "titleEn" = $2, "templateKo" = $3, "templateEn" = $4,
+			"active" = $5, "sortOrder" = $6, "updatedAt" = CURRENT_TIMESTAMP
+		WHERE "code" = $7
+		RETURNING "updatedAt"
+	`
+
+	err := q.QueryRow(ctx, query,
+		item.TitleKo, item.TitleEn, item.TemplateKo, item.TemplateEn, item.Active, item.SortOrder, item.Code,
+	).Scan(&item.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("거절 사유 코드 %s: %w", item.Code, ErrNotFound)
+		}
+		return fmt.Errorf("거절 사유 코드 업데이트 오류: %w", err)
+	}
+	return nil
+}
+
+func queryRejectReasons(ctx context.Context, q Querier, query string, args ...interface{}) ([]models.RejectReasonCode, error) {
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("거절 사유 코드 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.RejectReasonCode{}
+	for rows.Next() {
+		var item models.RejectReasonCode
+		err := rows.Scan(
+			&item.Code, &item.TitleKo, &item.TitleEn, &item.TemplateKo, &item.TemplateEn,
+			&item.Active, &item.SortOrder, &item.CreatedAt, &item.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
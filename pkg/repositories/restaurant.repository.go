@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"lambda-go/pkg/models"
@@ -26,8 +27,16 @@ func NewRestaurantRepository(dbPool *pgxpool.Pool) *RestaurantRepository {
 	}
 }
 
-// GetRestaurantRequests는 매장 생성 요청 목록을 조회합니다.
-func (r *RestaurantRepository) GetRestaurantRequests(ctx context.Context, query dto.RestaurantRequestQuery) ([]models.RestaurantRequest, int, error) {
+// restaurantRequestListColumns는 매장 요청 목록 조회 컬럼입니다.
+const restaurantRequestListColumns = `r."id", r."restaurantId", r."userId", r."rejectReason",
+	r."createdAt", r."updatedAt", r."deletedAt", r."status", r."type",
+	r."businessLicenseImageUrl", r."businessLicenseNumber", r."version",
+	r."assigneeId", r."claimedAt", r."claimExpiresAt", r."processedBy", r."processedAt",
+	r."rejectReasonCodes", r."rejectNote"`
+
+// restaurantRequestFilter는 매장 요청 목록 검색 조건을 WHERE 절과 파라미터로 변환합니다.
+// 점유 필터는 now 기준으로 만료된 점유를 미점유로 간주합니다.
+func restaurantRequestFilter(query dto.RestaurantRequestQuery, now time.Time) (string, []interface{}) {
 	// 쿼리 빌더 패턴 적용
 	whereClause := `WHERE "deletedAt" IS NULL`
 	params := []interface{}{}
@@ -40,10 +49,97 @@ func (r *RestaurantRepository) GetRestaurantRequests(ctx context.Context, query
 		paramIndex++
 	}
 
+	// 요청자 필터 적용
+	if query.UserID != nil {
+		whereClause += fmt.Sprintf(` AND "userId" = $%d`, paramIndex)
+		params = append(params, *query.UserID)
+		paramIndex++
+	}
+
+	// 검토자 점유 필터 적용 (만료된 점유는 미점유로 간주)
+	if query.Unassigned {
+		whereClause += fmt.Sprintf(` AND ("assigneeId" IS NULL OR "claimExpiresAt" <= $%d)`, paramIndex)
+		params = append(params, now)
+		paramIndex++
+	} else if query.AssigneeID != nil {
+		whereClause += fmt.Sprintf(` AND "assigneeId" = $%d AND "claimExpiresAt" > $%d`, paramIndex, paramIndex+1)
+		params = append(params, *query.AssigneeID, now)
+		paramIndex += 2
+	}
+
+	// 중복 의심 필터 적용
+	if query.Duplicate != nil {
+		condition := requestDuplicateCondition
+		if !*query.Duplicate {
+			condition = "NOT " + condition
+		}
+		whereClause += " AND " + condition
+	}
+
+	return whereClause, params
+}
+
+// scanRestaurantRequests는 restaurantRequestListColumns 순서로 조회한 행을 매장 요청 목록으로 변환합니다.
+func scanRestaurantRequests(rows pgx.Rows, now time.Time) ([]models.RestaurantRequest, error) {
+	defer rows.Close()
+
+	result := []models.RestaurantRequest{}
+	for rows.Next() {
+		var req models.RestaurantRequest
+		err := rows.Scan(
+			&req.ID, &req.RestaurantID, &req.UserID, &req.RejectReason,
+			&req.CreatedAt, &req.UpdatedAt, &req.DeletedAt, &req.Status, &req.Type,
+			&req.BusinessLicenseImageUrl, &req.BusinessLicenseNumber, &req.Version,
+			&req.AssigneeID, &req.ClaimedAt, &req.ClaimExpiresAt, &req.ProcessedBy, &req.ProcessedAt,
+			&req.RejectReasonCodes, &req.RejectNote,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+
+		req.ClearExpiredClaim(now)
+		result = append(result, req)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+	return result, nil
+}
+
+// prepareRestaurantRequestFilter는 중복 의심 필터가 있으면 q의 트랜잭션에 매장명 유사도 임계값을 설정합니다.
+func prepareRestaurantRequestFilter(ctx context.Context, q Querier, query dto.RestaurantRequestQuery) error {
+	if query.Duplicate == nil {
+		return nil
+	}
+	return setDuplicateNameThreshold(ctx, q)
+}
+
+// GetRestaurantRequests는 매장 생성 요청 목록을 조회합니다.
+// 중복 의심 필터가 있으면 매장명 유사도 임계값을 트랜잭션 범위로 설정해야 하므로 읽기 전용 트랜잭션 안에서 조회합니다.
+func (r *RestaurantRepository) GetRestaurantRequests(ctx context.Context, query dto.RestaurantRequestQuery) ([]models.RestaurantRequest, int, error) {
+	now := time.Now()
+	whereClause, params := restaurantRequestFilter(query, now)
+	paramIndex := len(params) + 1
+
+	var q Querier = r.dbPool
+	if query.Duplicate != nil {
+		tx, err := r.dbPool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+		if err != nil {
+			return nil, 0, fmt.Errorf("읽기 전용 트랜잭션 시작 오류: %w", err)
+		}
+		defer tx.Rollback(ctx)
+
+		if err := prepareRestaurantRequestFilter(ctx, tx, query); err != nil {
+			return nil, 0, err
+		}
+		q = tx
+	}
+
 	// 전체 개수 조회
 	var total int
-	countQuery := `SELECT COUNT(*) FROM "RestaurantRequest" ` + whereClause
-	err := r.dbPool.QueryRow(ctx, countQuery, params...).Scan(&total)
+	countQuery := `SELECT COUNT(*) FROM "RestaurantRequest" r ` + whereClause
+	err := q.QueryRow(ctx, countQuery, params...).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("요청 개수 조회 오류: %w", err)
 	}
@@ -54,93 +150,446 @@ func (r *RestaurantRepository) GetRestaurantRequests(ctx context.Context, query
 
 	// 요청 목록 조회
 	queryStr := fmt.Sprintf(`
-		SELECT r."id", r."restaurantId", r."userId", r."rejectReason", 
-			r."createdAt", r."updatedAt", r."deletedAt", r."status", r."type",
-			r."businessLicenseImageUrl", r."businessLicenseNumber"
+		SELECT %s
 		FROM "RestaurantRequest" r
 		%s
 		ORDER BY r."createdAt" DESC
 		LIMIT $%d OFFSET $%d
-	`, whereClause, paramIndex, paramIndex+1)
+	`, restaurantRequestListColumns, whereClause, paramIndex, paramIndex+1)
 
 	params = append(params, limit, offset)
 
-	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	rows, err := q.Query(ctx, queryStr, params...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("요청 목록 조회 오류: %w", err)
 	}
+
+	result, err := scanRestaurantRequests(rows, now)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
+// CountRestaurantRequestsForExport는 q(내보내기 스냅샷 트랜잭션)에서 검색 조건에 맞는 매장 요청 수를 조회합니다.
+func (r *RestaurantRepository) CountRestaurantRequestsForExport(ctx context.Context, q Querier, query dto.RestaurantRequestQuery, now time.Time) (int, error) {
+	whereClause, params := restaurantRequestFilter(query, now)
+	if err := prepareRestaurantRequestFilter(ctx, q, query); err != nil {
+		return 0, err
+	}
+
+	var total int
+	if err := q.QueryRow(ctx, `SELECT COUNT(*) FROM "RestaurantRequest" r `+whereClause, params...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("요청 개수 조회 오류: %w", err)
+	}
+	return total, nil
+}
+
+// GetRestaurantRequestsForExport는 q(내보내기 스냅샷 트랜잭션)에서 afterID보다 큰 ID의 매장 요청을 ID 순으로 최대 limit건 조회합니다.
+// OFFSET 대신 키셋으로 넘기므로 뒤 페이지로 갈수록 느려지지 않습니다. 첫 페이지는 afterID 0으로 조회합니다.
+func (r *RestaurantRepository) GetRestaurantRequestsForExport(ctx context.Context, q Querier, query dto.RestaurantRequestQuery, now time.Time, afterID, limit int) ([]models.RestaurantRequest, error) {
+	whereClause, params := restaurantRequestFilter(query, now)
+	if err := prepareRestaurantRequestFilter(ctx, q, query); err != nil {
+		return nil, err
+	}
+	paramIndex := len(params) + 1
+
+	queryStr := fmt.Sprintf(`
+		SELECT %s
+		FROM "RestaurantRequest" r
+		%s AND r."id" > $%d
+		ORDER BY r."id"
+		LIMIT $%d
+	`, restaurantRequestListColumns, whereClause, paramIndex, paramIndex+1)
+
+	rows, err := q.Query(ctx, queryStr, append(params, afterID, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("요청 목록 조회 오류: %w", err)
+	}
+	return scanRestaurantRequests(rows, now)
+}
+
+// GetRestaurantRequestByID는 ID로 매장 요청을 조회합니다.
+func (r *RestaurantRepository) GetRestaurantRequestByID(ctx context.Context, requestID string) (*models.RestaurantRequest, error) {
+	return findRestaurantRequest(ctx, r.dbPool, requestID, "")
+}
+
+// LockRestaurantRequest는 트랜잭션 안에서 매장 요청 행을 잠그고 조회합니다 (SELECT ... FOR UPDATE).
+func (r *RestaurantRepository) LockRestaurantRequest(ctx context.Context, q Querier, requestID string) (*models.RestaurantRequest, error) {
+	return findRestaurantRequest(ctx, q, requestID, "FOR UPDATE")
+}
+
+func findRestaurantRequest(ctx context.Context, q Querier, requestID string, lockClause string) (*models.RestaurantRequest, error) {
+	query := fmt.Sprintf(`
+		SELECT "id", "restaurantId", "userId", "rejectReason", "createdAt", "updatedAt", "status", "type",
+			"businessLicenseImageUrl", "businessLicenseNumber", "version",
+			"assigneeId", "claimedAt", "claimExpiresAt", "processedBy", "processedAt",
+			"rejectReasonCodes", "rejectNote"
+		FROM "RestaurantRequest"
+		WHERE "id" = $1 AND "deletedAt" IS NULL
+		%s
+	`, lockClause)
+
+	var req models.RestaurantRequest
+	err := q.QueryRow(ctx, query, requestID).Scan(
+		&req.ID, &req.RestaurantID, &req.UserID, &req.RejectReason,
+		&req.CreatedAt, &req.UpdatedAt, &req.Status, &req.Type,
+		&req.BusinessLicenseImageUrl, &req.BusinessLicenseNumber, &req.Version,
+		&req.AssigneeID, &req.ClaimedAt, &req.ClaimExpiresAt, &req.ProcessedBy, &req.ProcessedAt,
+		&req.RejectReasonCodes, &req.RejectNote,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("요청 ID %s: %w", requestID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("요청 조회 오류: %w", err)
+	}
+
+	req.ClearExpiredClaim(time.Now())
+	return &req, nil
+}
+
+// GetPendingLicenseNumbers는 요청들 중 대기 중인 요청의 사업자등록번호를 한 번에 조회합니다. 번호가 없는 요청은 제외합니다.
+func (r *RestaurantRepository) GetPendingLicenseNumbers(ctx context.Context, requestIDs []int64) ([]string, error) {
+	query := `
+		SELECT DISTINCT "businessLicenseNumber"
+		FROM "RestaurantRequest"
+		WHERE "id" = ANY($1) AND "status" = 'PENDING' AND "deletedAt" IS NULL
+			AND "businessLicenseNumber" IS NOT NULL
+	`
+
+	rows, err := r.dbPool.Query(ctx, query, requestIDs)
+	if err != nil {
+		return nil, fmt.Errorf("사업자등록번호 조회 오류: %w", err)
+	}
 	defer rows.Close()
 
-	// 모델 변환
-	var result []models.RestaurantRequest
+	numbers := []string{}
 	for rows.Next() {
-		var req models.RestaurantRequest
-		var rejectReason pgtype.Text
-		var deletedAt pgtype.Timestamp
-
-		err := rows.Scan(
-			&req.ID, &req.RestaurantID, &req.UserID, &rejectReason,
-			&req.CreatedAt, &req.UpdatedAt, &deletedAt, &req.Status, &req.Type,
-			&req.BusinessLicenseImageUrl, &req.BusinessLicenseNumber,
-		)
-		if err != nil {
-			return nil, 0, fmt.Errorf("행 스캔 오류: %w", err)
+		var number string
+		if err := rows.Scan(&number); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
 		}
+		numbers = append(numbers, number)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return numbers, nil
+}
 
-		if rejectReason.Status == pgtype.Present {
-			reason := rejectReason.String
-			req.RejectReason = &reason
+// GetRestaurantByID는 ID로 매장을 조회합니다.
+func (r *RestaurantRepository) GetRestaurantByID(ctx context.Context, restaurantID string) (*models.Restaurant, error) {
+	return findRestaurant(ctx, r.dbPool, restaurantID, "")
+}
+
+// LockRestaurant는 트랜잭션 안에서 매장 행을 잠그고 조회합니다 (SELECT ... FOR UPDATE).
+func (r *RestaurantRepository) LockRestaurant(ctx context.Context, q Querier, restaurantID string) (*models.Restaurant, error) {
+	return findRestaurant(ctx, q, restaurantID, "FOR UPDATE")
+}
+
+const restaurantColumns = `"id", "name", "description", "address", "phoneNumber", "ownerId",
+	"addressDescription", "eventDescription", "holiday",
+	"parkingAvailable", "parkingDescription", "deliveryAvailable",
+	"status", "createdAt", "updatedAt", "deletedAt"`
+
+func findRestaurant(ctx context.Context, q Querier, restaurantID string, lockClause string) (*models.Restaurant, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM "Restaurant"
+		WHERE "id" = $1
+		%s
+	`, restaurantColumns, lockClause)
+
+	restaurant, err := scanRestaurant(q.QueryRow(ctx, query, restaurantID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("매장 ID %s: %w", restaurantID, ErrNotFound)
 		}
+		return nil, fmt.Errorf("매장 조회 오류: %w", err)
+	}
+
+	return restaurant, nil
+}
+
+// scanRestaurant는 restaurantColumns 순서로 조회한 행을 매장 모델로 변환합니다.
+func scanRestaurant(row pgx.Row) (*models.Restaurant, error) {
+	var restaurant models.Restaurant
+	err := row.Scan(
+		&restaurant.ID, &restaurant.Name, &restaurant.Description, &restaurant.Address, &restaurant.PhoneNumber, &restaurant.OwnerID,
+		&restaurant.AddressDescription, &restaurant.EventDescription, &restaurant.Holiday,
+		&restaurant.ParkingAvailable, &restaurant.ParkingDescription, &restaurant.DeliveryAvailable,
+		&restaurant.Status, &restaurant.CreatedAt, &restaurant.UpdatedAt, &restaurant.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &restaurant, nil
+}
+
+// restaurantFilter는 매장 목록 검색 조건을 WHERE 절과 파라미터로 변환합니다.
+// 검색어는 매장명, 주소, 전화번호(숫자만 비교)에 부분 일치합니다.
+func restaurantFilter(query dto.RestaurantQuery) (string, []interface{}) {
+	var whereClause string
+	params := []interface{}{}
+	paramIndex := 1
 
-		if deletedAt.Status == pgtype.Present {
-			deleteTime := deletedAt.Time
-			req.DeletedAt = &deleteTime
+	// 삭제 여부 필터 적용
+	switch query.Deleted {
+	case dto.DELETED_INCLUDE:
+		whereClause = `WHERE TRUE`
+	case dto.DELETED_ONLY:
+		whereClause = `WHERE "deletedAt" IS NOT NULL`
+	default:
+		whereClause = `WHERE "deletedAt" IS NULL`
+	}
+
+	// 상태 필터 적용
+	if query.Status != nil {
+		whereClause += fmt.Sprintf(` AND "status" = $%d`, paramIndex)
+		params = append(params, string(*query.Status))
+		paramIndex++
+	}
+
+	// 점주 필터 적용
+	if query.OwnerID != nil {
+		whereClause += fmt.Sprintf(` AND "ownerId" = $%d`, paramIndex)
+		params = append(params, *query.OwnerID)
+		paramIndex++
+	}
+
+	// 검색어 필터 적용
+	if query.Search != nil {
+		whereClause += fmt.Sprintf(` AND ("name" ILIKE $%d OR "address" ILIKE $%d`, paramIndex, paramIndex)
+		params = append(params, "%"+escapeLike(*query.Search)+"%")
+		paramIndex++
+
+		if digits := normalizeDigits(*query.Search); digits != "" {
+			whereClause += fmt.Sprintf(` OR normalize_phone("phoneNumber") LIKE $%d`, paramIndex)
+			params = append(params, "%"+digits+"%")
 		}
+		whereClause += `)`
+	}
 
-		result = append(result, req)
+	return whereClause, params
+}
+
+// scanRestaurants는 restaurantColumns 순서로 조회한 행을 매장 목록으로 변환합니다.
+func scanRestaurants(rows pgx.Rows) ([]models.Restaurant, error) {
+	defer rows.Close()
+
+	result := []models.Restaurant{}
+	for rows.Next() {
+		restaurant, err := scanRestaurant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, *restaurant)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, 0, fmt.Errorf("행 반복 오류: %w", err)
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+	return result, nil
+}
+
+// GetRestaurants는 검색 조건에 맞는 매장 목록을 조회합니다.
+// 검색어는 매장명, 주소, 전화번호(숫자만 비교)에 부분 일치합니다.
+func (r *RestaurantRepository) GetRestaurants(ctx context.Context, query dto.RestaurantQuery) ([]models.Restaurant, int, error) {
+	whereClause, params := restaurantFilter(query)
+	paramIndex := len(params) + 1
+
+	// 전체 개수 조회
+	var total int
+	countQuery := `SELECT COUNT(*) FROM "Restaurant" ` + whereClause
+	if err := r.dbPool.QueryRow(ctx, countQuery, params...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("매장 개수 조회 오류: %w", err)
 	}
 
+	// 매장 목록 조회
+	queryStr := fmt.Sprintf(`
+		SELECT %s
+		FROM "Restaurant"
+		%s
+		ORDER BY "createdAt" DESC, "id"
+		LIMIT $%d OFFSET $%d
+	`, restaurantColumns, whereClause, paramIndex, paramIndex+1)
+
+	params = append(params, query.PageSize, (query.Page-1)*query.PageSize)
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("매장 목록 조회 오류: %w", err)
+	}
+
+	result, err := scanRestaurants(rows)
+	if err != nil {
+		return nil, 0, err
+	}
 	return result, total, nil
 }
 
-// GetRestaurantRequestByID는 ID로 매장 요청을 조회합니다.
-func (r *RestaurantRepository) GetRestaurantRequestByID(ctx context.Context, requestID string) (models.RestaurantRequestStatus, error) {
-	var status string
-	query := `SELECT "status" FROM "RestaurantRequest" WHERE "id" = $1 AND "deletedAt" IS NULL`
+// CountRestaurantsForExport는 q(내보내기 스냅샷 트랜잭션)에서 검색 조건에 맞는 매장 수를 조회합니다.
+func (r *RestaurantRepository) CountRestaurantsForExport(ctx context.Context, q Querier, query dto.RestaurantQuery) (int, error) {
+	whereClause, params := restaurantFilter(query)
 
-	err := r.dbPool.QueryRow(ctx, query, requestID).Scan(&status)
+	var total int
+	if err := q.QueryRow(ctx, `SELECT COUNT(*) FROM "Restaurant" `+whereClause, params...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("매장 개수 조회 오류: %w", err)
+	}
+	return total, nil
+}
+
+// GetRestaurantsForExport는 q(내보내기 스냅샷 트랜잭션)에서 afterID보다 큰 ID의 매장을 ID 순으로 최대 limit건 조회합니다.
+// 첫 페이지는 afterID ""로 조회합니다.
+func (r *RestaurantRepository) GetRestaurantsForExport(ctx context.Context, q Querier, query dto.RestaurantQuery, afterID string, limit int) ([]models.Restaurant, error) {
+	whereClause, params := restaurantFilter(query)
+	paramIndex := len(params) + 1
+
+	queryStr := fmt.Sprintf(`
+		SELECT %s
+		FROM "Restaurant"
+		%s AND "id" > $%d
+		ORDER BY "id"
+		LIMIT $%d
+	`, restaurantColumns, whereClause, paramIndex, paramIndex+1)
+
+	rows, err := q.Query(ctx, queryStr, append(params, afterID, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("매장 목록 조회 오류: %w", err)
+	}
+	return scanRestaurants(rows)
+}
+
+// GetRestaurantBusiness는 매장의 사업자 정보를 조회합니다. 사업자 정보가 없으면 nil을 반환합니다.
+func (r *RestaurantRepository) GetRestaurantBusiness(ctx context.Context, restaurantID string) (*models.RestaurantBusiness, error) {
+	query := `
+		SELECT "restaurantId", "name", "licenseImageUrl", "licenseNumber"
+		FROM "RestaurantBusiness"
+		WHERE "restaurantId" = $1
+	`
+
+	var business models.RestaurantBusiness
+	err := r.dbPool.QueryRow(ctx, query, restaurantID).Scan(
+		&business.RestaurantID, &business.Name, &business.LicenseImageUrl, &business.LicenseNumber,
+	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return "", fmt.Errorf("요청 ID %s를 찾을 수 없습니다", requestID)
+			return nil, nil
 		}
-		return "", fmt.Errorf("요청 조회 오류: %w", err)
+		return nil, fmt.Errorf("사업자 정보 조회 오류: %w", err)
 	}
 
-	return models.RestaurantRequestStatus(status), nil
+	return &business, nil
 }
 
-// ProcessRestaurantRequest는 매장 생성 요청을 처리합니다.
-func (r *RestaurantRepository) ProcessRestaurantRequest(ctx context.Context, requestID string, payload *models.ProcessRestaurantRequest) (*models.RestaurantRequest, error) {
-	// 트랜잭션 시작
-	tx, err := r.dbPool.Begin(ctx)
+// UpdateRestaurant는 매장의 수정 가능한 정보를 저장합니다. 삭제된 매장은 수정하지 않습니다.
+func (r *RestaurantRepository) UpdateRestaurant(ctx context.Context, q Querier, restaurant *models.Restaurant) error {
+	query := `
+		UPDATE "Restaurant"
+		SET "name" = $1, "description" = $2, "address" = $3, "phoneNumber" = $4,
+			"addressDescription" = $5, "eventDescription" = $6, "holiday" = $7,
+			"parkingAvailable" = $8, "parkingDescription" = $9, "deliveryAvailable" = $10,
+			"updatedAt" = $11
+		WHERE "id" = $12 AND "deletedAt" IS NULL
+	`
+
+	now := time.Now()
+	tag, err := q.Exec(ctx, query,
+		restaurant.Name, restaurant.Description, restaurant.Address, restaurant.PhoneNumber,
+		restaurant.AddressDescription, restaurant.EventDescription, restaurant.Holiday,
+		restaurant.ParkingAvailable, restaurant.ParkingDescription, restaurant.DeliveryAvailable,
+		now, restaurant.ID,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("트랜잭션 시작 오류: %w", err)
+		return fmt.Errorf("매장 정보 업데이트 오류: %w", err)
 	}
-	defer tx.Rollback(ctx)
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("매장 ID %s: %w", restaurant.ID, ErrNotFound)
+	}
+
+	restaurant.UpdatedAt = now
+	return nil
+}
+
+// SoftDeleteRestaurant는 매장의 deletedAt을 설정하여 삭제 처리하고 삭제 시간을 반환합니다.
+func (r *RestaurantRepository) SoftDeleteRestaurant(ctx context.Context, q Querier, restaurantID string) (time.Time, error) {
+	query := `
+		UPDATE "Restaurant"
+		SET "deletedAt" = $1, "updatedAt" = $1
+		WHERE "id" = $2 AND "deletedAt" IS NULL
+	`
 
-	// 현재 시간
 	now := time.Now()
+	tag, err := q.Exec(ctx, query, now, restaurantID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("매장 삭제 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return time.Time{}, fmt.Errorf("매장 ID %s: %w", restaurantID, ErrConflict)
+	}
+	return now, nil
+}
+
+// RestoreRestaurant는 삭제된 매장의 deletedAt을 비워 복구합니다.
+func (r *RestaurantRepository) RestoreRestaurant(ctx context.Context, q Querier, restaurantID string) error {
+	query := `
+		UPDATE "Restaurant"
+		SET "deletedAt" = NULL, "updatedAt" = $1
+		WHERE "id" = $2 AND "deletedAt" IS NOT NULL
+	`
+
+	tag, err := q.Exec(ctx, query, time.Now(), restaurantID)
+	if err != nil {
+		return fmt.Errorf("매장 복구 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("매장 ID %s: %w", restaurantID, ErrConflict)
+	}
+	return nil
+}
 
-	// 요청 상태 업데이트
+// escapeLike는 LIKE 패턴의 특수 문자(%, _, \)를 이스케이프합니다.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// normalizeDigits는 문자열에서 숫자만 남깁니다.
+func normalizeDigits(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, value)
+}
+
+// RestaurantRequestStatusUpdate는 매장 요청 상태 변경 시 함께 저장되는 값입니다.
+type RestaurantRequestStatusUpdate struct {
+	Status            models.RestaurantRequestStatus
+	RejectReason      *string  // 점주에게 보여줄 거절 사유 문구
+	RejectReasonCodes []string // 거절 사유 코드 목록
+	RejectNote        *string  // 검토자 비고
+	ProcessedBy       *string  // 검토 결정을 내린 관리자 ID
+}
+
+// UpdateRestaurantRequestStatus는 버전이 일치하는 경우에만 매장 요청 상태를 변경합니다.
+// 다른 요청이 먼저 변경하여 버전이 달라졌다면 ErrConflict를 반환합니다.
+// ProcessedBy가 주어지면 검토 결정으로 보고 처리자와 처리 시간을 기록하며, nil이면 둘 다 비웁니다.
+func (r *RestaurantRepository) UpdateRestaurantRequestStatus(ctx context.Context, q Querier, requestID string, expectedVersion int, update RestaurantRequestStatusUpdate) (*models.RestaurantRequest, error) {
+	// 요청 상태 업데이트 (버전 조건부)
 	updateRequestQuery := `
 		UPDATE "RestaurantRequest"
-		SET "status" = $1, "updatedAt" = $2, "rejectReason" = $3
-		WHERE "id" = $4 AND "deletedAt" IS NULL
-		RETURNING "id", "restaurantId", "userId", "rejectReason", "createdAt", "updatedAt", "deletedAt", "status"
+		SET "status" = $1, "updatedAt" = $2, "rejectReason" = $3, "version" = "version" + 1,
+			"assigneeId" = NULL, "claimedAt" = NULL, "claimExpiresAt" = NULL,
+			"processedBy" = $6, "processedAt" = CASE WHEN $6::text IS NULL THEN NULL ELSE $2 END,
+			"rejectReasonCodes" = $7, "rejectNote" = $8
+		WHERE "id" = $4 AND "version" = $5 AND "deletedAt" IS NULL
+		RETURNING "id", "restaurantId", "userId", "rejectReason", "createdAt", "updatedAt", "deletedAt", "status", "type",
+			"businessLicenseImageUrl", "businessLicenseNumber", "version", "processedBy", "processedAt",
+			"rejectReasonCodes", "rejectNote"
 	`
 
 	// 결과 저장 변수
@@ -150,22 +599,28 @@ func (r *RestaurantRepository) ProcessRestaurantRequest(ctx context.Context, req
 
 	// 값 설정
 	var rejectReasonVal pgtype.Text
-	if payload.RejectReason != nil {
-		rejectReasonVal.String = *payload.RejectReason
+	if update.RejectReason != nil {
+		rejectReasonVal.String = *update.RejectReason
 		rejectReasonVal.Status = pgtype.Present
 	} else {
 		rejectReasonVal.Status = pgtype.Null
 	}
 
 	// 업데이트 실행 및 결과 스캔
-	err = tx.QueryRow(ctx, updateRequestQuery,
-		payload.Status, now, rejectReasonVal, requestID,
+	err := q.QueryRow(ctx, updateRequestQuery,
+		update.Status, time.Now(), rejectReasonVal, requestID, expectedVersion, update.ProcessedBy,
+		update.RejectReasonCodes, update.RejectNote,
 	).Scan(
 		&request.ID, &request.RestaurantID, &request.UserID, &rejectReasonSQL,
-		&request.CreatedAt, &request.UpdatedAt, &deletedAtSQL, &request.Status,
+		&request.CreatedAt, &request.UpdatedAt, &deletedAtSQL, &request.Status, &request.Type,
+		&request.BusinessLicenseImageUrl, &request.BusinessLicenseNumber, &request.Version,
+		&request.ProcessedBy, &request.ProcessedAt, &request.RejectReasonCodes, &request.RejectNote,
 	)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("요청 ID %s: %w", requestID, ErrConflict)
+		}
 		return nil, fmt.Errorf("요청 업데이트 오류: %w", err)
 	}
 
@@ -180,31 +635,42 @@ func (r *RestaurantRepository) ProcessRestaurantRequest(ctx context.Context, req
 		request.DeletedAt = &deleteTime
 	}
 
-	// 승인된 경우에만 Restaurant 상태 업데이트
-	if payload.Status == models.APPROVED {
-		// Restaurant 상태를 HIDDEN으로 업데이트
-		updateRestaurantQuery := `
-			UPDATE "Restaurant"
-			SET "status" = $1, "updatedAt" = $2
-			WHERE "id" = $3
-		`
+	return &request, nil
+}
 
-		_, err = tx.Exec(ctx, updateRestaurantQuery,
-			models.HIDDEN, // HIDDEN 상태로 설정
-			now,
-			request.RestaurantID,
-		)
+// UpdateRestaurantRequestClaim은 매장 요청의 검토자 점유 정보를 변경합니다.
+// assigneeID가 nil이면 점유를 해제합니다. 점유는 검토 결과가 아니므로 버전을 올리지 않습니다.
+func (r *RestaurantRepository) UpdateRestaurantRequestClaim(ctx context.Context, q Querier, requestID string, assigneeID *string, claimedAt, claimExpiresAt *time.Time) error {
+	query := `
+		UPDATE "RestaurantRequest"
+		SET "assigneeId" = $1, "claimedAt" = $2, "claimExpiresAt" = $3
+		WHERE "id" = $4 AND "deletedAt" IS NULL
+	`
 
-		if err != nil {
-			return nil, fmt.Errorf("매장 상태 업데이트 오류: %w", err)
-		}
+	tag, err := q.Exec(ctx, query, assigneeID, claimedAt, claimExpiresAt, requestID)
+	if err != nil {
+		return fmt.Errorf("요청 점유 정보 업데이트 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("요청 ID %s: %w", requestID, ErrNotFound)
 	}
+	return nil
+}
+
+// UpdateRestaurantStatus는 매장 상태를 변경합니다.
+func (r *RestaurantRepository) UpdateRestaurantStatus(ctx context.Context, q Querier, restaurantID string, status models.RestaurantStatus) error {
+	updateRestaurantQuery := `
+		UPDATE "Restaurant"
+		SET "status" = $1, "updatedAt" = $2
+		WHERE "id" = $3
+	`
 
-	// 트랜잭션 커밋
-	err = tx.Commit(ctx)
+	tag, err := q.Exec(ctx, updateRestaurantQuery, status, time.Now(), restaurantID)
 	if err != nil {
-		return nil, fmt.Errorf("트랜잭션 커밋 오류: %w", err)
+		return fmt.Errorf("매장 상태 업데이트 오류: %w", err)
 	}
-
-	return &request, nil
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("매장 ID %s: %w", restaurantID, ErrNotFound)
+	}
+	return nil
 }
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"lambda-go/pkg/models"
+)
+
+const (
+	// duplicateNameSimilarity는 매장명을 중복 후보로 판단하는 최소 트라이그램 유사도입니다.
+	// 목록 필터는 이 값을 % 연산자의 임계값(pg_trgm.similarity_threshold)으로 설정하여 매장명 트라이그램 인덱스로 후보를 찾습니다.
+	duplicateNameSimilarity = 0.6
+
+	// duplicateNameLimit는 상세 조회 시 반환할 매장명 유사 후보 최대 수입니다.
+	duplicateNameLimit = 20
+)
+
+// setDuplicateNameThreshold는 q의 트랜잭션 안에서 % 연산자의 유사도 임계값을 duplicateNameSimilarity로 설정합니다.
+// set_limit과 달리 트랜잭션 범위로만 설정하므로 트랜잭션 풀링 커넥션에서도 다른 요청에 영향을 주지 않습니다.
+// requestDuplicateCondition을 사용하는 쿼리보다 먼저, 같은 트랜잭션에서 호출해야 합니다.
+func setDuplicateNameThreshold(ctx context.Context, q Querier) error {
+	threshold := strconv.FormatFloat(duplicateNameSimilarity, 'f', -1, 64)
+	if _, err := q.Exec(ctx, `SELECT set_config('pg_trgm.similarity_threshold', $1, true)`, threshold); err != nil {
+		return fmt.Errorf("매장명 유사도 임계값 설정 오류: %w", err)
+	}
+	return nil
+}
+
+// requestDuplicateCondition은 매장 요청 r에 중복 후보가 하나라도 있는지 확인하는 조건입니다.
+// FindRestaurantRequestDuplicates와 같은 기준을 사용하며, 매장명은 setDuplicateNameThreshold로 설정한 임계값의 % 연산자로 비교합니다.
+const requestDuplicateCondition = `EXISTS (
+		SELECT 1 FROM "Restaurant" self
+		WHERE self."id" = r."restaurantId" AND (
+			(normalize_digits(r."businessLicenseNumber") <> '' AND (
+				EXISTS (
+					SELECT 1 FROM "RestaurantRequest" o
+					WHERE o."deletedAt" IS NULL AND o."restaurantId" <> r."restaurantId"
+						AND normalize_digits(o."businessLicenseNumber") = normalize_digits(r."businessLicenseNumber")
+				)
+				OR EXISTS (
+					SELECT 1 FROM "RestaurantBusiness" b
+					JOIN "Restaurant" x ON x."id" = b."restaurantId"
+					WHERE x."deletedAt" IS NULL AND x."id" <> self."id"
+						AND normalize_digits(b."licenseNumber") = normalize_digits(r."businessLicenseNumber")
+				)
+			))
+			OR EXISTS (
+				SELECT 1 FROM "Restaurant" x
+				WHERE x."deletedAt" IS NULL AND x."id" <> self."id" AND (
+					(normalize_phone(self."phoneNumber") <> '' AND normalize_phone(x."phoneNumber") = normalize_phone(self."phoneNumber"))
+					OR (normalize_address(self."address") <> '' AND normalize_address(x."address") = normalize_address(self."address"))
+					OR lower(x."name") % lower(self."name")
+				)
+			)
+		)
+	)`
+
+// FindRestaurantRequestDuplicates는 매장 요청과 중복으로 의심되는 매장과 다른 매장 요청을 조회합니다.
+// 같은 매장에 대한 이전 요청(재신청)은 중복으로 보지 않습니다.
+func (r *RestaurantRepository) FindRestaurantRequestDuplicates(ctx context.Context, requestID string) ([]models.DuplicateWarning, error) {
+	query := `
+		WITH src AS (
+			SELECT req."id", req."restaurantId",
+				normalize_digits(req."businessLicenseNumber") AS license,
+				normalize_phone(rest."phoneNumber") AS phone,
+				normalize_address(rest."address") AS address,
+				lower(rest."name") AS name
+			FROM "RestaurantRequest" req
+			JOIN "Restaurant" rest ON rest."id" = req."restaurantId"
+			WHERE req."id" = $1 AND req."deletedAt" IS NULL
+		)
+		SELECT 'LICENSE_NUMBER', 'RESTAURANT_REQUEST', o."id"::text, x."name", o."status"::text, NULL::float8
+		FROM src
+		JOIN "RestaurantRequest" o ON normalize_digits(o."businessLicenseNumber") = src.license
+		JOIN "Restaurant" x ON x."id" = o."restaurantId"
+		WHERE src.license <> '' AND o."deletedAt" IS NULL AND o."restaurantId" <> src."restaurantId"
+
+		UNION ALL
+		SELECT 'LICENSE_NUMBER', 'RESTAURANT', x."id"::text, x."name", x."status"::text, NULL::float8
+		FROM src
+		JOIN "RestaurantBusiness" b ON normalize_digits(b."licenseNumber") = src.license
+		JOIN "Restaurant" x ON x."id" = b."restaurantId"
+		WHERE src.license <> '' AND x."deletedAt" IS NULL AND x."id" <> src."restaurantId"
+
+		UNION ALL
+		SELECT 'PHONE_NUMBER', 'RESTAURANT', x."id"::text, x."name", x."status"::text, NULL::float8
+		FROM src
+		JOIN "Restaurant" x ON normalize_phone(x."phoneNumber") = src.phone
+		WHERE src.phone <> '' AND x."deletedAt" IS NULL AND x."id" <> src."restaurantId"
+
+		UNION ALL
+		SELECT 'ADDRESS', 'RESTAURANT', x."id"::text, x."name", x."status"::text, NULL::float8
+		FROM src
+		JOIN "Restaurant" x ON normalize_address(x."address") = src.address
+		WHERE src.address <> '' AND x."deletedAt" IS NULL AND x."id" <> src."restaurantId"
+
+		UNION ALL
+		(
+			SELECT 'NAME', 'RESTAURANT', x."id"::text, x."name", x."status"::text, similarity(lower(x."name"), src.name)::float8
+			FROM src
+			JOIN "Restaurant" x ON lower(x."name") % src.name
+			WHERE x."deletedAt" IS NULL AND x."id" <> src."restaurantId"
+				AND similarity(lower(x."name"), src.name) >= $2
+			ORDER BY 6 DESC
+			LIMIT $3
+		)
+	`
+
+	rows, err := r.dbPool.Query(ctx, query, requestID, duplicateNameSimilarity, duplicateNameLimit)
+	if err != nil {
+		return nil, fmt.Errorf("중복 후보 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.DuplicateWarning{}
+	for rows.Next() {
+		var item models.DuplicateWarning
+		if err := rows.Scan(&item.Field, &item.TargetType, &item.TargetID, &item.Name, &item.Status, &item.Similarity); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// duplicateTestTx는 TEST_DATABASE_URL의 데이터베이스에 중복 탐지 마이그레이션을 적용한 트랜잭션을 반환합니다.
+// 정규화 함수와 pg_trgm은 PostgreSQL 안에서만 동작하므로 TEST_DATABASE_URL이 없으면 건너뜁니다. 트랜잭션은 테스트 후 롤백됩니다.
+func duplicateTestTx(t *testing.T) pgx.Tx {
+	t.Helper()
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL이 없어 PostgreSQL 테스트를 건너뜁니다")
+	}
+
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, databaseURL)
+	if err != nil {
+		t.Fatalf("데이터베이스 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		t.Fatalf("트랜잭션 시작 실패: %v", err)
+	}
+	t.Cleanup(func() { tx.Rollback(ctx) })
+
+	migration, err := os.ReadFile("../../migrations/0010_duplicate_detection.sql")
+	if err != nil {
+		t.Fatalf("마이그레이션 읽기 실패: %v", err)
+	}
+	if _, err := tx.Exec(ctx, string(migration)); err != nil {
+		t.Fatalf("마이그레이션 적용 실패: %v", err)
+	}
+	return tx
+}
+
+func TestDuplicateNormalization(t *testing.T) {
+	tx := duplicateTestTx(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		function string
+		value    *string
+		want     string
+	}{
+		{"normalize_digits", strPtr("220-81-62517"), "2208162517"},
+		{"normalize_digits", nil, ""},
+		{"normalize_phone", strPtr("010-1234-5678"), "01012345678"},
+		{"normalize_phone", strPtr("+82 10-1234-5678"), "01012345678"},
+		{"normalize_phone", strPtr("+82-2-123-4567"), "021234567"},
+		{"normalize_phone", strPtr("(02) 123 4567"), "021234567"},
+		{"normalize_phone", nil, ""},
+		{"normalize_address", strPtr("서울시 강남구 테헤란로 1-2, 3층"), "서울시강남구테헤란로123층"},
+		{"normalize_address", strPtr("Seoul  Gangnam-gu Teheran-ro 1"), "seoulgangnamguteheranro1"},
+		{"normalize_address", nil, ""},
+	}
+
+	for _, tt := range tests {
+		var got string
+		if err := tx.QueryRow(ctx, `SELECT `+tt.function+`($1)`, tt.value).Scan(&got); err != nil {
+			t.Fatalf("%s 실행 실패: %v", tt.function, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s(%v) = %q, want %q", tt.function, derefString(tt.value), got, tt.want)
+		}
+	}
+}
+
+func TestDuplicateNameThreshold(t *testing.T) {
+	tx := duplicateTestTx(t)
+	ctx := context.Background()
+
+	if err := setDuplicateNameThreshold(ctx, tx); err != nil {
+		t.Fatalf("setDuplicateNameThreshold() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		other string
+		want  bool
+	}{
+		{"Starbucks Gangnam", "STARBUCKS GANGNAM", true},         // 유사도 1.0
+		{"Starbucks Gangnam", "Starbucks Gangnam Station", true}, // 유사도 약 0.78
+		{"Pizza Hut", "Pizza Hat", false},                        // 유사도 약 0.54, 기본 임계값 0.3이면 일치
+		{"Starbucks Gangnam", "Burger House", false},
+	}
+
+	for _, tt := range tests {
+		var got bool
+		if err := tx.QueryRow(ctx, `SELECT lower($1) % lower($2)`, tt.name, tt.other).Scan(&got); err != nil {
+			t.Fatalf("유사도 비교 실패: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("%q %% %q = %v, want %v", tt.name, tt.other, got, tt.want)
+		}
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return "NULL"
+	}
+	return *s
+}
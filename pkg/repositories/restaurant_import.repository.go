@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+)
+
+// CreateRestaurant는 매장을 등록합니다. 등록/수정 시각을 restaurant에 채웁니다.
+func (r *RestaurantRepository) CreateRestaurant(ctx context.Context, q Querier, restaurant *models.Restaurant) error {
+	query := `
+		INSERT INTO "Restaurant" (
+			"id", "name", "description", "address", "phoneNumber", "ownerId",
+			"addressDescription", "eventDescription", "holiday",
+			"parkingAvailable", "parkingDescription", "deliveryAvailable",
+			"status", "createdAt", "updatedAt"
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $14)
+	`
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	_, err := q.Exec(ctx, query,
+		restaurant.ID, restaurant.Name, restaurant.Description, restaurant.Address, restaurant.PhoneNumber, restaurant.OwnerID,
+		restaurant.AddressDescription, restaurant.EventDescription, restaurant.Holiday,
+		restaurant.ParkingAvailable, restaurant.ParkingDescription, restaurant.DeliveryAvailable,
+		restaurant.Status, now,
+	)
+	if err != nil {
+		return fmt.Errorf("매장 등록 오류: %w", err)
+	}
+
+	restaurant.CreatedAt = now
+	restaurant.UpdatedAt = now
+	return nil
+}
+
+// CreateRestaurantBusiness는 매장의 사업자 정보를 등록합니다.
+func (r *RestaurantRepository) CreateRestaurantBusiness(ctx context.Context, q Querier, business *models.RestaurantBusiness) error {
+	query := `
+		INSERT INTO "RestaurantBusiness" ("restaurantId", "name", "licenseImageUrl", "licenseNumber")
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := q.Exec(ctx, query, business.RestaurantID, business.Name, business.LicenseImageUrl, business.LicenseNumber)
+	if err != nil {
+		return fmt.Errorf("사업자 정보 등록 오류: %w", err)
+	}
+	return nil
+}
+
+// FindRestaurantIDsByLicenseNumbers는 사업자등록번호(숫자만)별로 이미 사용 중인 삭제되지 않은 매장 ID를 조회합니다.
+func (r *RestaurantRepository) FindRestaurantIDsByLicenseNumbers(ctx context.Context, licenseNumbers []string) (map[string]string, error) {
+	result := map[string]string{}
+	if len(licenseNumbers) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT normalize_digits(b."licenseNumber"), b."restaurantId"
+		FROM "RestaurantBusiness" b
+		JOIN "Restaurant" x ON x."id" = b."restaurantId"
+		WHERE x."deletedAt" IS NULL AND normalize_digits(b."licenseNumber") = ANY($1)
+	`
+
+	rows, err := r.dbPool.Query(ctx, query, licenseNumbers)
+	if err != nil {
+		return nil, fmt.Errorf("사업자등록번호 사용 매장 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var licenseNumber, restaurantID string
+		if err := rows.Scan(&licenseNumber, &restaurantID); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result[licenseNumber] = restaurantID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const restaurantMenuColumns = `"id", "restaurantId", "name", "price", "description", "imageUrl",
+	"hidden", "imageHidden", "moderationReason", "moderatedBy", "moderatedAt"`
+
+// GetRestaurantMenus는 매장의 메뉴 목록을 이름 순으로 조회합니다. hidden이 주어지면 숨김 여부로 필터링합니다.
+func (r *RestaurantRepository) GetRestaurantMenus(ctx context.Context, restaurantID string, hidden *bool) ([]models.RestaurantMenu, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM "RestaurantMenu"
+		WHERE "restaurantId" = $1 AND ($2::boolean IS NULL OR "hidden" = $2)
+		ORDER BY "name", "id"
+	`, restaurantMenuColumns)
+
+	return queryRestaurantMenus(ctx, r.dbPool, query, restaurantID, hidden)
+}
+
+// LockRestaurantMenu는 트랜잭션 안에서 매장 메뉴를 행 잠금(FOR UPDATE)으로 조회합니다.
+// 메뉴가 해당 매장에 속하지 않으면 ErrNotFound를 반환합니다.
+func (r *RestaurantRepository) LockRestaurantMenu(ctx context.Context, q Querier, restaurantID, menuID string) (*models.RestaurantMenu, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM "RestaurantMenu"
+		WHERE "id" = $1 AND "restaurantId" = $2
+		FOR UPDATE
+	`, restaurantMenuColumns)
+
+	menu, err := scanRestaurantMenu(q.QueryRow(ctx, query, menuID, restaurantID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("메뉴 ID %s: %w", menuID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("메뉴 조회 오류: %w", err)
+	}
+
+	return menu, nil
+}
+
+// LockVisibleRestaurantMenus는 트랜잭션 안에서 매장의 숨기지 않은 메뉴를 행 잠금(FOR UPDATE)으로 조회합니다.
+func (r *RestaurantRepository) LockVisibleRestaurantMenus(ctx context.Context, q Querier, restaurantID string) ([]models.RestaurantMenu, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM "RestaurantMenu"
+		WHERE "restaurantId" = $1 AND "hidden" = FALSE
+		ORDER BY "id"
+		FOR UPDATE
+	`, restaurantMenuColumns)
+
+	return queryRestaurantMenus(ctx, q, query, restaurantID)
+}
+
+// UpdateRestaurantMenu는 메뉴 정보와 검수 정보를 저장합니다.
+func (r *RestaurantRepository) UpdateRestaurantMenu(ctx context.Context, q Querier, menu *models.RestaurantMenu) error {
+	query := `
+		UPDATE "RestaurantMenu"
+		SET "name" = $1, "price" = $2, "description" = $3,
+			"hidden" = $4, "imageHidden" = $5,
+			"moderationReason" = $6, "moderatedBy" = $7, "moderatedAt" = $8
+		WHERE "id" = $9
+	`
+
+	tag, err := q.Exec(ctx, query,
+		menu.Name, menu.Price, menu.Description,
+		menu.Hidden, menu.ImageHidden,
+		menu.ModerationReason, menu.ModeratedBy, menu.ModeratedAt,
+		menu.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("메뉴 업데이트 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("메뉴 ID %s: %w", menu.ID, ErrNotFound)
+	}
+	return nil
+}
+
+// HideRestaurantMenus는 매장의 숨기지 않은 메뉴를 모두 숨기고 숨긴 메뉴 수를 반환합니다.
+func (r *RestaurantRepository) HideRestaurantMenus(ctx context.Context, q Querier, restaurantID, reason, moderatedBy string, moderatedAt time.Time) (int64, error) {
+	query := `
+		UPDATE "RestaurantMenu"
+		SET "hidden" = TRUE, "moderationReason" = $1, "moderatedBy" = $2, "moderatedAt" = $3
+		WHERE "restaurantId" = $4 AND "hidden" = FALSE
+	`
+
+	tag, err := q.Exec(ctx, query, reason, moderatedBy, moderatedAt, restaurantID)
+	if err != nil {
+		return 0, fmt.Errorf("메뉴 일괄 숨김 오류: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func queryRestaurantMenus(ctx context.Context, q Querier, query string, args ...interface{}) ([]models.RestaurantMenu, error) {
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("메뉴 목록 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.RestaurantMenu{}
+	for rows.Next() {
+		menu, err := scanRestaurantMenu(rows)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, *menu)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+// scanRestaurantMenu는 restaurantMenuColumns 순서로 조회한 행을 메뉴 모델로 변환합니다.
+func scanRestaurantMenu(row pgx.Row) (*models.RestaurantMenu, error) {
+	var menu models.RestaurantMenu
+	err := row.Scan(
+		&menu.ID, &menu.RestaurantID, &menu.Name, &menu.Price, &menu.Description, &menu.ImageURL,
+		&menu.Hidden, &menu.ImageHidden, &menu.ModerationReason, &menu.ModeratedBy, &menu.ModeratedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &menu, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+)
+
+// GetRequestQueueDepth는 현재 상태/유형별 매장 요청 수를 조회합니다.
+func (r *RestaurantRepository) GetRequestQueueDepth(ctx context.Context) ([]models.QueueDepth, error) {
+	query := `
+		SELECT "status", "type", COUNT(*)
+		FROM "RestaurantRequest"
+		WHERE "deletedAt" IS NULL
+		GROUP BY "status", "type"
+		ORDER BY "status", "type"
+	`
+
+	rows, err := r.dbPool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("큐 현황 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.QueueDepth{}
+	for rows.Next() {
+		var item models.QueueDepth
+		if err := rows.Scan(&item.Status, &item.Type, &item.Count); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetRequestDecisionTime은 기간 내 처리된 요청의 처리 소요 시간 중앙값/p90과 SLA 초과 처리 건수를 조회합니다.
+func (r *RestaurantRepository) GetRequestDecisionTime(ctx context.Context, query dto.RestaurantRequestStatsQuery) (models.DecisionTimeStats, int, error) {
+	queryStr := `
+		WITH decided AS (
+			SELECT EXTRACT(EPOCH FROM ("processedAt" - "createdAt")) / 3600.0 AS hours
+			FROM "RestaurantRequest"
+			WHERE "deletedAt" IS NULL
+				AND "status" IN ('APPROVED', 'REJECTED')
+				AND "processedAt" >= $1 AND "processedAt" < $2
+		)
+		SELECT
+			COUNT(*),
+			PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY hours),
+			PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY hours),
+			COUNT(*) FILTER (WHERE hours > $3)
+		FROM decided
+	`
+
+	var stats models.DecisionTimeStats
+	var breaches int
+	err := r.dbPool.QueryRow(ctx, queryStr, query.From, query.To, query.SLA.Hours()).Scan(
+		&stats.Count, &stats.MedianHours, &stats.P90Hours, &breaches,
+	)
+	if err != nil {
+		return stats, 0, fmt.Errorf("처리 소요 시간 조회 오류: %w", err)
+	}
+
+	return stats, breaches, nil
+}
+
+// GetPendingSLABreaches는 SLA를 초과해 대기 중인 요청 수와 가장 오래된 요청 목록을 조회합니다.
+func (r *RestaurantRepository) GetPendingSLABreaches(ctx context.Context, query dto.RestaurantRequestStatsQuery) (int, []models.SLABreach, error) {
+	now := time.Now()
+	deadline := now.Add(-query.SLA)
+
+	var total int
+	countQuery := `
+		SELECT COUNT(*)
+		FROM "RestaurantRequest"
+		WHERE "deletedAt" IS NULL AND "status" = 'PENDING' AND "createdAt" < $1
+	`
+	if err := r.dbPool.QueryRow(ctx, countQuery, deadline).Scan(&total); err != nil {
+		return 0, nil, fmt.Errorf("SLA 초과 건수 조회 오류: %w", err)
+	}
+
+	listQuery := `
+		SELECT "id", "restaurantId", "type",
+			CASE WHEN "claimExpiresAt" > $2 THEN "assigneeId" END,
+			"createdAt", EXTRACT(EPOCH FROM ($2 - "createdAt")) / 3600.0
+		FROM "RestaurantRequest"
+		WHERE "deletedAt" IS NULL AND "status" = 'PENDING' AND "createdAt" < $1
+		ORDER BY "createdAt" ASC
+		LIMIT $3
+	`
+
+	rows, err := r.dbPool.Query(ctx, listQuery, deadline, now, query.BreachMax)
+	if err != nil {
+		return 0, nil, fmt.Errorf("SLA 초과 요청 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	breaches := []models.SLABreach{}
+	for rows.Next() {
+		var item models.SLABreach
+		err := rows.Scan(&item.ID, &item.RestaurantID, &item.Type, &item.AssigneeID, &item.CreatedAt, &item.WaitingHours)
+		if err != nil {
+			return 0, nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		breaches = append(breaches, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return total, breaches, nil
+}
+
+// GetReviewerStats는 기간 내 검토자별 승인/거절 건수와 비율을 조회합니다.
+func (r *RestaurantRepository) GetReviewerStats(ctx context.Context, query dto.RestaurantRequestStatsQuery) ([]models.ReviewerStats, error) {
+	queryStr := `
+		SELECT
+			"processedBy",
+			COUNT(*) FILTER (WHERE "status" = 'APPROVED'),
+			COUNT(*) FILTER (WHERE "status" = 'REJECTED'),
+			COUNT(*),
+			COUNT(*) FILTER (WHERE "status" = 'APPROVED')::float8 / COUNT(*),
+			COUNT(*) FILTER (WHERE "status" = 'REJECTED')::float8 / COUNT(*)
+		FROM "RestaurantRequest"
+		WHERE "deletedAt" IS NULL
+			AND "processedBy" IS NOT NULL
+			AND "status" IN ('APPROVED', 'REJECTED')
+			AND "processedAt" >= $1 AND "processedAt" < $2
+		GROUP BY "processedBy"
+		ORDER BY COUNT(*) DESC
+	`
+
+	rows, err := r.dbPool.Query(ctx, queryStr, query.From, query.To)
+	if err != nil {
+		return nil, fmt.Errorf("검토자 통계 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.ReviewerStats{}
+	for rows.Next() {
+		var item models.ReviewerStats
+		err := rows.Scan(&item.ReviewerID, &item.Approved, &item.Rejected, &item.Total, &item.ApprovalRatio, &item.RejectionRatio)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
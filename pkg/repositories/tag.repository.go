@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// TagRepository는 태그와 매장-태그 연결 데이터 액세스를 처리합니다.
+type TagRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewTagRepository는 새 TagRepository 인스턴스를 생성합니다.
+func NewTagRepository(dbPool *pgxpool.Pool) *TagRepository {
+	return &TagRepository{
+		dbPool: dbPool,
+	}
+}
+
+// tagUsageColumn은 삭제되지 않은 매장 중 태그가 붙은 매장 수를 계산합니다.
+const tagUsageColumn = `(
+	SELECT COUNT(*) FROM "RestaurantTag" rt
+	JOIN "Restaurant" r ON r."id" = rt."restaurantId"
+	WHERE rt."tagId" = t."id" AND r."deletedAt" IS NULL
+)`
+
+// List는 태그 목록을 사용 매장 수와 함께 조회합니다.
+func (r *TagRepository) List(ctx context.Context, query dto.TagQuery) ([]models.Tag, int, error) {
+	whereClause := `WHERE TRUE`
+	params := []interface{}{}
+	paramIndex := 1
+
+	// 검색어 필터 적용 (정규화한 이름 부분 일치)
+	if query.Search != nil {
+		whereClause += fmt.Sprintf(` AND normalize_tag_name(t."name") LIKE $%d`, paramIndex)
+		params = append(params, "%"+escapeLike(strings.ToLower(strings.Join(strings.Fields(*query.Search), " ")))+"%")
+		paramIndex++
+	}
+
+	// 전체 개수 조회
+	var total int
+	countQuery := `SELECT COUNT(*) FROM "Tag" t ` + whereClause
+	if err := r.dbPool.QueryRow(ctx, countQuery, params...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("태그 개수 조회 오류: %w", err)
+	}
+
+	orderBy := `normalize_tag_name(t."name"), t."id"`
+	if query.Sort == dto.TAG_SORT_USAGE {
+		orderBy = `"usageCount" DESC, ` + orderBy
+	}
+
+	// 태그 목록 조회
+	queryStr := fmt.Sprintf(`
+		SELECT t."id", t."name", t."description", %s AS "usageCount"
+		FROM "Tag" t
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, tagUsageColumn, whereClause, orderBy, paramIndex, paramIndex+1)
+
+	params = append(params, query.PageSize, (query.Page-1)*query.PageSize)
+
+	tags, err := queryTags(ctx, r.dbPool, queryStr, params...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tags, total, nil
+}
+
+// GetByID는 태그를 사용 매장 수와 함께 조회합니다.
+func (r *TagRepository) GetByID(ctx context.Context, tagID int) (*models.Tag, error) {
+	return r.FindByID(ctx, r.dbPool, tagID)
+}
+
+// FindByID는 주어진 트랜잭션 안에서 태그를 사용 매장 수와 함께 조회합니다.
+func (r *TagRepository) FindByID(ctx context.Context, q Querier, tagID int) (*models.Tag, error) {
+	query := fmt.Sprintf(`
+		SELECT t."id", t."name", t."description", %s AS "usageCount"
+		FROM "Tag" t
+		WHERE t."id" = $1
+	`, tagUsageColumn)
+
+	tags, err := queryTags(ctx, q, query, tagID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("태그 ID %d: %w", tagID, ErrNotFound)
+	}
+	return &tags[0], nil
+}
+
+// LockByIDs는 트랜잭션 안에서 태그 행을 잠그고 조회합니다.
+// forUpdate가 false면 공유 잠금(FOR SHARE)으로 병합/삭제만 막습니다. 없는 ID는 결과에서 빠집니다.
+func (r *TagRepository) LockByIDs(ctx context.Context, q Querier, tagIDs []int, forUpdate bool) ([]models.Tag, error) {
+	lockClause := `FOR SHARE`
+	if forUpdate {
+		lockClause = `FOR UPDATE`
+	}
+
+	query := fmt.Sprintf(`
+		SELECT t."id", t."name", t."description", NULL::bigint
+		FROM "Tag" t
+		WHERE t."id" = ANY($1)
+		ORDER BY t."id"
+		%s
+	`, lockClause)
+
+	return queryTags(ctx, q, query, tagIDs)
+}
+
+// Create는 태그를 추가합니다. 정규화한 이름이 같은 태그가 이미 있으면 ErrConflict를 반환합니다.
+func (r *TagRepository) Create(ctx context.Context, q Querier, tag *models.Tag) error {
+	query := `
+		INSERT INTO "Tag" ("name", "description")
+		VALUES ($1, $2)
+		ON CONFLICT (normalize_tag_name("name")) DO NOTHING
+		RETURNING "id"
+	`
+
+	err := q.QueryRow(ctx, query, tag.Name, tag.Description).Scan(&tag.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || isUniqueViolation(err) {
+			return fmt.Errorf("태그 %s: %w", tag.Name, ErrConflict)
+		}
+		return fmt.Errorf("태그 저장 오류: %w", err)
+	}
+	return nil
+}
+
+// Update는 태그의 이름과 설명을 변경합니다. 정규화한 이름이 다른 태그와 같으면 ErrConflict를 반환합니다.
+func (r *TagRepository) Update(ctx context.Context, q Querier, tag *models.Tag) error {
+	query := `UPDATE "Tag" SET "name" = $1, "description" = $2 WHERE "id" = $3`
+
+	tagResult, err := q.Exec(ctx, query, tag.Name, tag.Description, tag.ID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("태그 %s: %w", tag.Name, ErrConflict)
+		}
+		return fmt.Errorf("태그 업데이트 오류: %w", err)
+	}
+	if tagResult.RowsAffected() == 0 {
+		return fmt.Errorf("태그 ID %d: %w", tag.ID, ErrNotFound)
+	}
+	return nil
+}
+
+// Delete는 태그와 태그의 매장 연결을 삭제하고, 삭제한 매장 연결 수를 반환합니다.
+func (r *TagRepository) Delete(ctx context.Context, q Querier, tagID int) (int64, error) {
+	links, err := q.Exec(ctx, `DELETE FROM "RestaurantTag" WHERE "tagId" = $1`, tagID)
+	if err != nil {
+		return 0, fmt.Errorf("태그 연결 삭제 오류: %w", err)
+	}
+
+	tagResult, err := q.Exec(ctx, `DELETE FROM "Tag" WHERE "id" = $1`, tagID)
+	if err != nil {
+		return 0, fmt.Errorf("태그 삭제 오류: %w", err)
+	}
+	if tagResult.RowsAffected() == 0 {
+		return 0, fmt.Errorf("태그 ID %d: %w", tagID, ErrNotFound)
+	}
+	return links.RowsAffected(), nil
+}
+
+// Merge는 원본 태그들의 매장 연결을 대상 태그로 옮기고 원본 태그를 삭제합니다.
+// 같은 매장에 대상 태그나 다른 원본 태그가 이미 붙어 있으면 중복 연결은 옮기지 않고 삭제합니다.
+// 반드시 트랜잭션 안에서 호출해야 하며, 옮긴 연결 수와 삭제한 중복 연결 수를 반환합니다.
+func (r *TagRepository) Merge(ctx context.Context, q Querier, targetID int, sourceIDs []int) (moved int64, duplicates int64, err error) {
+	deleted, err := q.Exec(ctx, `
+		DELETE FROM "RestaurantTag" a
+		WHERE a."tagId" = ANY($2)
+			AND EXISTS (
+				SELECT 1 FROM "RestaurantTag" b
+				WHERE b."restaurantId" = a."restaurantId"
+					AND (b."tagId" = $1 OR (b."tagId" = ANY($2) AND b."id" < a."id"))
+			)
+	`, targetID, sourceIDs)
+	if err != nil {
+		return 0, 0, fmt.Errorf("중복 태그 연결 삭제 오류: %w", err)
+	}
+
+	updated, err := q.Exec(ctx, `UPDATE "RestaurantTag" SET "tagId" = $1 WHERE "tagId" = ANY($2)`, targetID, sourceIDs)
+	if err != nil {
+		return 0, 0, fmt.Errorf("태그 연결 이동 오류: %w", err)
+	}
+
+	if _, err := q.Exec(ctx, `DELETE FROM "Tag" WHERE "id" = ANY($1)`, sourceIDs); err != nil {
+		return 0, 0, fmt.Errorf("원본 태그 삭제 오류: %w", err)
+	}
+
+	return updated.RowsAffected(), deleted.RowsAffected(), nil
+}
+
+// GetByRestaurant는 매장에 붙은 태그를 이름 순으로 조회합니다.
+func (r *TagRepository) GetByRestaurant(ctx context.Context, restaurantID string) ([]models.Tag, error) {
+	return r.FindByRestaurant(ctx, r.dbPool, restaurantID)
+}
+
+// FindByRestaurant는 주어진 트랜잭션 안에서 매장에 붙은 태그를 이름 순으로 조회합니다.
+func (r *TagRepository) FindByRestaurant(ctx context.Context, q Querier, restaurantID string) ([]models.Tag, error) {
+	query := `
+		SELECT t."id", t."name", t."description", NULL::bigint
+		FROM "Tag" t
+		JOIN "RestaurantTag" rt ON rt."tagId" = t."id"
+		WHERE rt."restaurantId" = $1
+		ORDER BY normalize_tag_name(t."name"), t."id"
+	`
+
+	return queryTags(ctx, q, query, restaurantID)
+}
+
+// Assign은 매장에 태그들을 붙이고 새로 붙인 태그 수를 반환합니다. 이미 붙은 태그는 무시합니다.
+func (r *TagRepository) Assign(ctx context.Context, q Querier, restaurantID string, tagIDs []int) (int64, error) {
+	query := `
+		INSERT INTO "RestaurantTag" ("restaurantId", "tagId")
+		SELECT $1, unnest($2::int[])
+		ON CONFLICT ("restaurantId", "tagId") DO NOTHING
+	`
+
+	tagResult, err := q.Exec(ctx, query, restaurantID, tagIDs)
+	if err != nil {
+		return 0, fmt.Errorf("매장 태그 지정 오류: %w", err)
+	}
+	return tagResult.RowsAffected(), nil
+}
+
+// Unassign은 매장에서 태그를 뗍니다. 붙어 있지 않은 태그면 ErrNotFound를 반환합니다.
+func (r *TagRepository) Unassign(ctx context.Context, q Querier, restaurantID string, tagID int) error {
+	query := `DELETE FROM "RestaurantTag" WHERE "restaurantId" = $1 AND "tagId" = $2`
+
+	tagResult, err := q.Exec(ctx, query, restaurantID, tagID)
+	if err != nil {
+		return fmt.Errorf("매장 태그 삭제 오류: %w", err)
+	}
+	if tagResult.RowsAffected() == 0 {
+		return fmt.Errorf("매장 %s의 태그 ID %d: %w", restaurantID, tagID, ErrNotFound)
+	}
+	return nil
+}
+
+func queryTags(ctx context.Context, q Querier, query string, args ...interface{}) ([]models.Tag, error) {
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("태그 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.Tag{}
+	for rows.Next() {
+		var tag models.Tag
+		var usageCount *int64
+		if err := rows.Scan(&tag.ID, &tag.Name, &tag.Description, &usageCount); err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		if usageCount != nil {
+			count := int(*usageCount)
+			tag.UsageCount = &count
+		}
+		result = append(result, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+var (
+	// ErrNotFound는 조회 대상 행이 없음을 나타냅니다.
+	ErrNotFound = errors.New("대상을 찾을 수 없습니다")
+	// ErrConflict는 동시 수정으로 조건부 업데이트가 실패했음을 나타냅니다.
+	ErrConflict = errors.New("다른 요청에 의해 이미 변경되었습니다")
+)
+
+// isUniqueViolation은 유니크 제약 조건 위반 에러인지 확인합니다.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// Querier는 커넥션 풀과 트랜잭션이 공통으로 제공하는 쿼리 메서드입니다.
+type Querier interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// TxManager는 여러 리포지토리 호출을 하나의 트랜잭션으로 묶습니다.
+type TxManager struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewTxManager는 새 TxManager 인스턴스를 생성합니다.
+func NewTxManager(dbPool *pgxpool.Pool) *TxManager {
+	return &TxManager{
+		dbPool: dbPool,
+	}
+}
+
+// RunInTx는 fn을 트랜잭션 안에서 실행합니다.
+// fn이 에러를 반환하면 롤백하고 그 에러를 그대로 반환하며, 성공하면 커밋합니다.
+func (m *TxManager) RunInTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := m.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("트랜잭션 시작 오류: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("트랜잭션 커밋 오류: %w", err)
+	}
+	return nil
+}
+
+// RunInReadOnlySnapshot은 fn을 읽기 전용 REPEATABLE READ 트랜잭션 안에서 실행합니다.
+// fn 안의 모든 조회는 트랜잭션 시작 시점의 같은 스냅샷을 보므로, 여러 번 나누어 조회해도 행이 빠지거나 중복되지 않습니다.
+func (m *TxManager) RunInReadOnlySnapshot(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := m.dbPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("읽기 전용 트랜잭션 시작 오류: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("트랜잭션 커밋 오류: %w", err)
+	}
+	return nil
+}
+
+// RunInSavepoint는 fn을 진행 중인 트랜잭션의 세이브포인트 안에서 실행합니다.
+// fn이 실패하면 세이브포인트까지만 롤백되어 바깥 트랜잭션은 계속 사용할 수 있습니다.
+func RunInSavepoint(ctx context.Context, tx pgx.Tx, fn func(tx pgx.Tx) error) error {
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("세이브포인트 생성 오류: %w", err)
+	}
+	defer sp.Rollback(ctx)
+
+	if err := fn(sp); err != nil {
+		return err
+	}
+
+	if err := sp.Commit(ctx); err != nil {
+		return fmt.Errorf("세이브포인트 해제 오류: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"lambda-go/pkg/models"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// UploadRepository는 presigned 업로드 등록부 데이터 액세스를 처리합니다.
+type UploadRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewUploadRepository는 새 UploadRepository 인스턴스를 생성합니다.
+func NewUploadRepository(dbPool *pgxpool.Pool) *UploadRepository {
+	return &UploadRepository{
+		dbPool: dbPool,
+	}
+}
+
+const uploadColumns = `"id", "userId", "purpose", "bucket", "key", "contentType", "maxFileSize", "status",
+	"size", "etag", "createdAt", "confirmedAt", "deletedAt", "deleteReason"`
+
+// uploadReferenceTargets는 업로드 객체를 참조할 수 있는 테이블과 컬럼입니다. 값은 키 또는 키로 끝나는 URL입니다.
+var uploadReferenceTargets = [][2]string{
+	{"RestaurantImage", "imageUrl"},
+	{"RestaurantMenu", "imageUrl"},
+	{"RestaurantRequest", "businessLicenseImageUrl"},
+	{"RestaurantBusiness", "licenseImageUrl"},
+}
+
+// scanUpload는 uploadColumns 순서로 조회한 행을 업로드 모델로 변환합니다.
+func scanUpload(row pgx.Row) (*models.Upload, error) {
+	var upload models.Upload
+	err := row.Scan(
+		&upload.ID, &upload.UserID, &upload.Purpose, &upload.Bucket, &upload.Key, &upload.ContentType, &upload.MaxFileSize, &upload.Status,
+		&upload.Size, &upload.ETag, &upload.CreatedAt, &upload.ConfirmedAt, &upload.DeletedAt, &upload.DeleteReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+// CreateUpload는 발급한 업로드 URL을 PENDING 상태로 등록합니다.
+func (r *UploadRepository) CreateUpload(ctx context.Context, upload *models.Upload) error {
+	query := `
+		INSERT INTO "Upload" ("id", "userId", "purpose", "bucket", "key", "contentType", "maxFileSize")
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING "status", "createdAt"
+	`
+
+	err := r.dbPool.QueryRow(ctx, query,
+		upload.ID, upload.UserID, upload.Purpose, upload.Bucket, upload.Key, upload.ContentType, upload.MaxFileSize,
+	).Scan(&upload.Status, &upload.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("업로드 등록 오류: %w", err)
+	}
+	return nil
+}
+
+// GetUpload는 ID로 업로드를 조회합니다.
+func (r *UploadRepository) GetUpload(ctx context.Context, uploadID string) (*models.Upload, error) {
+	query := fmt.Sprintf(`SELECT %s FROM "Upload" WHERE "id" = $1`, uploadColumns)
+
+	upload, err := scanUpload(r.dbPool.QueryRow(ctx, query, uploadID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("업로드 ID %s: %w", uploadID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("업로드 조회 오류: %w", err)
+	}
+	return upload, nil
+}
+
+// ConfirmUpload는 HEAD로 확인한 객체 크기와 ETag를 기록합니다. 이미 확인한 업로드는 값을 갱신합니다.
+// 삭제된 업로드면 ErrConflict를 반환합니다.
+func (r *UploadRepository) ConfirmUpload(ctx context.Context, uploadID string, size int64, etag string) (*models.Upload, error) {
+	query := fmt.Sprintf(`
+		UPDATE "Upload"
+		SET "status" = $2, "size" = $3, "etag" = $4, "confirmedAt" = COALESCE("confirmedAt", CURRENT_TIMESTAMP)
+		WHERE "id" = $1 AND "status" <> $5
+		RETURNING %s
+	`, uploadColumns)
+
+	upload, err := scanUpload(r.dbPool.QueryRow(ctx, query, uploadID, models.UPLOAD_CONFIRMED, size, etag, models.UPLOAD_DELETED))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("업로드 ID %s: %w", uploadID, ErrConflict)
+		}
+		return nil, fmt.Errorf("업로드 확인 기록 오류: %w", err)
+	}
+	return upload, nil
+}
+
+// MarkUploadDeleted는 객체를 삭제한 업로드를 DELETED로 기록합니다.
+func (r *UploadRepository) MarkUploadDeleted(ctx context.Context, uploadID, reason string) error {
+	query := `
+		UPDATE "Upload"
+		SET "status" = $2, "deletedAt" = CURRENT_TIMESTAMP, "deleteReason" = $3
+		WHERE "id" = $1 AND "status" <> $2
+	`
+
+	if _, err := r.dbPool.Exec(ctx, query, uploadID, models.UPLOAD_DELETED, reason); err != nil {
+		return fmt.Errorf("업로드 삭제 기록 오류: %w", err)
+	}
+	return nil
+}
+
+// FindCleanupCandidates는 정리할 업로드를 오래된 순으로 최대 limit건 조회합니다.
+// pendingBefore 전에 발급하고 확인하지 않은 업로드와, confirmedBefore 전에 확인했지만 참조하지 않는 업로드가 대상입니다.
+// 어느 쪽이든 매장 이미지, 메뉴 이미지, 사업자등록증 이미지에서 참조 중이면 제외합니다.
+func (r *UploadRepository) FindCleanupCandidates(ctx context.Context, pendingBefore, confirmedBefore time.Time, limit int) ([]models.Upload, error) {
+	references := make([]string, len(uploadReferenceTargets))
+	for i, target := range uploadReferenceTargets {
+		references[i] = fmt.Sprintf(`
+			SELECT 1 FROM "%[1]s" ref
+			WHERE ref."%[2]s" = u."key" OR right(ref."%[2]s", length(u."key") + 1) = '/' || u."key"`, target[0], target[1])
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM "Upload" u
+		WHERE (
+			(u."status" = $1 AND u."createdAt" < $3)
+			OR (u."status" = $2 AND u."confirmedAt" < $4)
+		)
+		AND NOT EXISTS (%s
+		)
+		ORDER BY u."createdAt", u."id"
+		LIMIT $5
+	`, uploadColumns, strings.Join(references, "\n\t\t\tUNION ALL"))
+
+	rows, err := r.dbPool.Query(ctx, query, models.UPLOAD_PENDING, models.UPLOAD_CONFIRMED, pendingBefore, confirmedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("정리 대상 업로드 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	uploads := []models.Upload{}
+	for rows.Next() {
+		upload, err := scanUpload(rows)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		uploads = append(uploads, *upload)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return uploads, nil
+}
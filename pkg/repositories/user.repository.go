@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// UserRepository는 사용자 계정과 관리자 세션 데이터 액세스를 처리합니다.
+type UserRepository struct {
+	dbPool *pgxpool.Pool
+}
+
+// NewUserRepository는 새 UserRepository 인스턴스를 생성합니다.
+func NewUserRepository(dbPool *pgxpool.Pool) *UserRepository {
+	return &UserRepository{
+		dbPool: dbPool,
+	}
+}
+
+// userColumns는 사용자 조회 컬럼입니다.
+const userColumns = `"id", "email", "name", "role"::text, "suspendedAt", "suspendedBy", "suspendReason"`
+
+// scanUser는 userColumns 순서로 조회한 행을 사용자 모델로 변환합니다.
+func scanUser(row pgx.Row) (*models.User, error) {
+	var user models.User
+	err := row.Scan(&user.ID, &user.Email, &user.Name, &user.Role, &user.SuspendedAt, &user.SuspendedBy, &user.SuspendReason)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUsers는 검색 조건에 맞는 사용자 목록을 조회합니다.
+func (r *UserRepository) GetUsers(ctx context.Context, query dto.UserQuery) ([]models.User, int, error) {
+	whereClause := `WHERE TRUE`
+	params := []interface{}{}
+	paramIndex := 1
+
+	// 검색어 필터 적용 (ID는 정확히 일치, 이메일/이름은 부분 일치)
+	if query.Search != nil {
+		whereClause += fmt.Sprintf(` AND ("id" = $%d OR "email" ILIKE $%d OR "name" ILIKE $%d)`, paramIndex, paramIndex+1, paramIndex+1)
+		params = append(params, *query.Search, "%"+escapeLike(*query.Search)+"%")
+		paramIndex += 2
+	}
+
+	// 역할 필터 적용
+	if query.Role != nil {
+		whereClause += fmt.Sprintf(` AND "role" = $%d`, paramIndex)
+		params = append(params, string(*query.Role))
+		paramIndex++
+	}
+
+	// 정지 여부 필터 적용
+	if query.Suspended != nil {
+		if *query.Suspended {
+			whereClause += ` AND "suspendedAt" IS NOT NULL`
+		} else {
+			whereClause += ` AND "suspendedAt" IS NULL`
+		}
+	}
+
+	// 전체 개수 조회
+	var total int
+	countQuery := `SELECT COUNT(*) FROM "User" ` + whereClause
+	if err := r.dbPool.QueryRow(ctx, countQuery, params...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("사용자 개수 조회 오류: %w", err)
+	}
+
+	// 사용자 목록 조회
+	queryStr := fmt.Sprintf(`
+		SELECT %s
+		FROM "User"
+		%s
+		ORDER BY "email", "id"
+		LIMIT $%d OFFSET $%d
+	`, userColumns, whereClause, paramIndex, paramIndex+1)
+
+	params = append(params, query.PageSize, (query.Page-1)*query.PageSize)
+
+	rows, err := r.dbPool.Query(ctx, queryStr, params...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("사용자 목록 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	result := []models.User{}
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result = append(result, *user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, total, nil
+}
+
+// GetUserByID는 ID로 사용자를 조회합니다.
+func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (*models.User, error) {
+	return findUser(ctx, r.dbPool, userID, "")
+}
+
+// GetUsersByIDs는 ID 목록에 해당하는 사용자를 ID별로 조회합니다. 없는 ID는 결과에 포함되지 않습니다.
+func (r *UserRepository) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]models.User, error) {
+	result := map[string]models.User{}
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM "User" WHERE "id" = ANY($1)`, userColumns)
+
+	rows, err := r.dbPool.Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("사용자 목록 조회 오류: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("행 스캔 오류: %w", err)
+		}
+		result[user.ID] = *user
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("행 반복 오류: %w", err)
+	}
+
+	return result, nil
+}
+
+// LockUser는 트랜잭션 안에서 사용자 행을 잠그고 조회합니다.
+func (r *UserRepository) LockUser(ctx context.Context, q Querier, userID string) (*models.User, error) {
+	return findUser(ctx, q, userID, "FOR UPDATE")
+}
+
+func findUser(ctx context.Context, q Querier, userID string, lockClause string) (*models.User, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM "User"
+		WHERE "id" = $1
+		%s
+	`, userColumns, lockClause)
+
+	user, err := scanUser(q.QueryRow(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("사용자 ID %s: %w", userID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("사용자 조회 오류: %w", err)
+	}
+
+	return user, nil
+}
+
+// SuspendUser는 사용자 계정을 정지하고 정지 시각을 반환합니다.
+// 이미 정지된 계정이면 다른 요청이 먼저 변경한 것으로 보고 ErrConflict를 반환합니다.
+func (r *UserRepository) SuspendUser(ctx context.Context, q Querier, userID, reason, suspendedBy string) (time.Time, error) {
+	query := `
+		UPDATE "User"
+		SET "suspendedAt" = $2, "suspendedBy" = $3, "suspendReason" = $4
+		WHERE "id" = $1 AND "suspendedAt" IS NULL
+	`
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	tag, err := q.Exec(ctx, query, userID, now, suspendedBy, reason)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("사용자 정지 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return time.Time{}, fmt.Errorf("사용자 ID %s: %w", userID, ErrConflict)
+	}
+
+	return now, nil
+}
+
+// UnsuspendUser는 사용자 계정 정지를 해제합니다.
+func (r *UserRepository) UnsuspendUser(ctx context.Context, q Querier, userID string) error {
+	query := `
+		UPDATE "User"
+		SET "suspendedAt" = NULL, "suspendedBy" = NULL, "suspendReason" = NULL
+		WHERE "id" = $1 AND "suspendedAt" IS NOT NULL
+	`
+
+	tag, err := q.Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("사용자 정지 해제 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("사용자 ID %s: %w", userID, ErrConflict)
+	}
+
+	return nil
+}
+
+// UpdateUserRole은 사용자 역할을 변경합니다.
+func (r *UserRepository) UpdateUserRole(ctx context.Context, q Querier, userID string, role models.Role) error {
+	query := `
+		UPDATE "User"
+		SET "role" = $2
+		WHERE "id" = $1
+	`
+
+	tag, err := q.Exec(ctx, query, userID, string(role))
+	if err != nil {
+		return fmt.Errorf("사용자 역할 변경 오류: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("사용자 ID %s: %w", userID, ErrNotFound)
+	}
+
+	return nil
+}
+
+// CountAdminSessions는 사용자의 관리자 세션 수를 조회합니다.
+func (r *UserRepository) CountAdminSessions(ctx context.Context, userID string) (int, error) {
+	var count int
+	err := r.dbPool.QueryRow(ctx, `SELECT COUNT(*) FROM "AdminSession" WHERE "userId" = $1`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("관리자 세션 개수 조회 오류: %w", err)
+	}
+	return count, nil
+}
+
+// RevokeAdminSessions는 사용자의 관리자 세션을 모두 삭제하고 삭제한 세션 수를 반환합니다.
+// 세션이 삭제되면 다음 요청부터 세션 검증에 실패하므로 다시 로그인해야 합니다.
+func (r *UserRepository) RevokeAdminSessions(ctx context.Context, q Querier, userID string) (int64, error) {
+	tag, err := q.Exec(ctx, `DELETE FROM "AdminSession" WHERE "userId" = $1`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("관리자 세션 삭제 오류: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
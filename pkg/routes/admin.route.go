@@ -9,7 +9,23 @@ import (
 // RestaurantHandler는 Restaurant 관련 핸들러 인터페이스
 type RestaurantHandler interface {
 	GetRestaurantRequests(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetRestaurantRequestStats(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetRestaurantRequest(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
 	ProcessRestaurantRequest(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	VerifyRestaurantRequestLicense(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	BulkProcessRestaurantRequests(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ClaimRestaurantRequest(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ReleaseRestaurantRequest(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ReopenRestaurantRequest(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	WithdrawRestaurantRequest(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ChangeRestaurantStatus(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetRestaurants(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetRestaurant(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	UpdateRestaurant(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	DeleteRestaurant(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	RestoreRestaurant(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetBusinessSchedule(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ReplaceBusinessSchedule(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
 }
 
 func RegisterAdminRoutes(router Router, h RestaurantHandler) {
@@ -18,7 +34,31 @@ func RegisterAdminRoutes(router Router, h RestaurantHandler) {
 		Path:     "/admin/restaurant/request",
 		Method:   "GET",
 		Handler:  h.GetRestaurantRequests,
-		AuthType: NoAuth,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 요청 SLA/처리 통계 API ({id} 경로보다 먼저 등록)
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/request/stats",
+		Method:   "GET",
+		Handler:  h.GetRestaurantRequestStats,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 요청 일괄 처리 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/request/bulk-process",
+		Method:   "POST",
+		Handler:  h.BulkProcessRestaurantRequests,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 요청 상세 조회 API (ETag 포함)
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/request/{id}",
+		Method:   "GET",
+		Handler:  h.GetRestaurantRequest,
+		AuthType: SessionAuth,
 	})
 
 	// 매장 생성 요청 처리 API
@@ -26,6 +66,497 @@ func RegisterAdminRoutes(router Router, h RestaurantHandler) {
 		Path:     "/admin/restaurant/request/{id}/process",
 		Method:   "POST",
 		Handler:  h.ProcessRestaurantRequest,
-		AuthType: NoAuth,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 요청 사업자등록번호 상태 재조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/request/{id}/license/verify",
+		Method:   "POST",
+		Handler:  h.VerifyRestaurantRequestLicense,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 요청 점유 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/request/{id}/claim",
+		Method:   "POST",
+		Handler:  h.ClaimRestaurantRequest,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 요청 점유 해제 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/request/{id}/release",
+		Method:   "POST",
+		Handler:  h.ReleaseRestaurantRequest,
+		AuthType: SessionAuth,
+	})
+
+	// 거절된 매장 요청 재검토 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/request/{id}/reopen",
+		Method:   "POST",
+		Handler:  h.ReopenRestaurantRequest,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 요청 철회 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/request/{id}/withdraw",
+		Method:   "POST",
+		Handler:  h.WithdrawRestaurantRequest,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 상태 변경 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/status",
+		Method:   "POST",
+		Handler:  h.ChangeRestaurantStatus,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 목록 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant",
+		Method:   "GET",
+		Handler:  h.GetRestaurants,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 상세 조회 API (/admin/restaurant/request 경로보다 나중에 등록)
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}",
+		Method:   "GET",
+		Handler:  h.GetRestaurant,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 정보 수정 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}",
+		Method:   "PUT",
+		Handler:  h.UpdateRestaurant,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 삭제 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}",
+		Method:   "DELETE",
+		Handler:  h.DeleteRestaurant,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 복구 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/restore",
+		Method:   "POST",
+		Handler:  h.RestoreRestaurant,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 영업 일정 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/business-hours",
+		Method:   "GET",
+		Handler:  h.GetBusinessSchedule,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 영업 일정 교체 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/business-hours",
+		Method:   "PUT",
+		Handler:  h.ReplaceBusinessSchedule,
+		AuthType: SessionAuth,
+	})
+}
+
+// MenuHandler는 매장 메뉴 검수 관련 핸들러 인터페이스
+type MenuHandler interface {
+	GetRestaurantMenus(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ModerateRestaurantMenu(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	HideRestaurantMenus(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterMenuRoutes(router Router, h MenuHandler) {
+	// 매장 메뉴 목록 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/menu",
+		Method:   "GET",
+		Handler:  h.GetRestaurantMenus,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 메뉴 일괄 숨김 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/menu/hide",
+		Method:   "POST",
+		Handler:  h.HideRestaurantMenus,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 메뉴 검수(수정/숨김) API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/menu/{menuId}",
+		Method:   "PUT",
+		Handler:  h.ModerateRestaurantMenu,
+		AuthType: SessionAuth,
+	})
+}
+
+// AuditHandler는 감사 로그 관련 핸들러 인터페이스
+type AuditHandler interface {
+	GetAuditLogs(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterAuditRoutes(router Router, h AuditHandler) {
+	// 감사 로그 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/audit-log",
+		Method:   "GET",
+		Handler:  h.GetAuditLogs,
+		AuthType: SessionAuth,
+	})
+}
+
+// RejectReasonHandler는 거절 사유 코드 관련 핸들러 인터페이스
+type RejectReasonHandler interface {
+	GetRejectReasonCodes(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	CreateRejectReasonCode(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	UpdateRejectReasonCode(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	DeactivateRejectReasonCode(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterRejectReasonRoutes(router Router, h RejectReasonHandler) {
+	// 거절 사유 코드 목록 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/reject-reason",
+		Method:   "GET",
+		Handler:  h.GetRejectReasonCodes,
+		AuthType: SessionAuth,
+	})
+
+	// 거절 사유 코드 생성 API
+	router.AddRoute(Route{
+		Path:     "/admin/reject-reason",
+		Method:   "POST",
+		Handler:  h.CreateRejectReasonCode,
+		AuthType: SessionAuth,
+	})
+
+	// 거절 사유 코드 수정 API
+	router.AddRoute(Route{
+		Path:     "/admin/reject-reason/{code}",
+		Method:   "PUT",
+		Handler:  h.UpdateRejectReasonCode,
+		AuthType: SessionAuth,
+	})
+
+	// 거절 사유 코드 비활성화 API
+	router.AddRoute(Route{
+		Path:     "/admin/reject-reason/{code}",
+		Method:   "DELETE",
+		Handler:  h.DeactivateRejectReasonCode,
+		AuthType: SessionAuth,
+	})
+}
+
+// TagHandler는 태그 관련 핸들러 인터페이스
+type TagHandler interface {
+	GetTags(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetTag(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	CreateTag(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	UpdateTag(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	DeleteTag(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	MergeTags(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetRestaurantTags(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	AssignRestaurantTags(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	RemoveRestaurantTag(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterTagRoutes(router Router, h TagHandler) {
+	// 태그 목록 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/tag",
+		Method:   "GET",
+		Handler:  h.GetTags,
+		AuthType: SessionAuth,
+	})
+
+	// 태그 생성 API
+	router.AddRoute(Route{
+		Path:     "/admin/tag",
+		Method:   "POST",
+		Handler:  h.CreateTag,
+		AuthType: SessionAuth,
+	})
+
+	// 태그 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/tag/{id}",
+		Method:   "GET",
+		Handler:  h.GetTag,
+		AuthType: SessionAuth,
+	})
+
+	// 태그 수정 API
+	router.AddRoute(Route{
+		Path:     "/admin/tag/{id}",
+		Method:   "PUT",
+		Handler:  h.UpdateTag,
+		AuthType: SessionAuth,
+	})
+
+	// 태그 삭제 API
+	router.AddRoute(Route{
+		Path:     "/admin/tag/{id}",
+		Method:   "DELETE",
+		Handler:  h.DeleteTag,
+		AuthType: SessionAuth,
+	})
+
+	// 태그 병합 API
+	router.AddRoute(Route{
+		Path:     "/admin/tag/{id}/merge",
+		Method:   "POST",
+		Handler:  h.MergeTags,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 태그 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/tag",
+		Method:   "GET",
+		Handler:  h.GetRestaurantTags,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 태그 지정 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/tag",
+		Method:   "POST",
+		Handler:  h.AssignRestaurantTags,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 태그 삭제 API
+	router.AddRoute(Route{
+		Path:     "/admin/restaurant/{id}/tag/{tagId}",
+		Method:   "DELETE",
+		Handler:  h.RemoveRestaurantTag,
+		AuthType: SessionAuth,
+	})
+}
+
+// OrderHandler는 주문 관련 핸들러 인터페이스
+type OrderHandler interface {
+	GetOrders(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetOrder(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	CancelOrder(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetInFlightDeliveries(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	CorrectDeliveryStatus(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterOrderRoutes(router Router, h OrderHandler) {
+	// 주문 목록 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/order",
+		Method:   "GET",
+		Handler:  h.GetOrders,
+		AuthType: SessionAuth,
+	})
+
+	// 주문 상세 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/order/{id}",
+		Method:   "GET",
+		Handler:  h.GetOrder,
+		AuthType: SessionAuth,
+	})
+
+	// 주문 강제 취소 API
+	router.AddRoute(Route{
+		Path:     "/admin/order/{id}/cancel",
+		Method:   "POST",
+		Handler:  h.CancelOrder,
+		AuthType: SessionAuth,
+	})
+
+	// 주문 배달 상태 정정 API
+	router.AddRoute(Route{
+		Path:     "/admin/order/{id}/delivery/status",
+		Method:   "POST",
+		Handler:  h.CorrectDeliveryStatus,
+		AuthType: SessionAuth,
+	})
+
+	// 배달 중인 주문 배달 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/delivery/in-flight",
+		Method:   "GET",
+		Handler:  h.GetInFlightDeliveries,
+		AuthType: SessionAuth,
+	})
+}
+
+// AnalyticsHandler는 매출/주문 분석 관련 핸들러 인터페이스
+type AnalyticsHandler interface {
+	GetSalesAnalytics(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetTopRestaurants(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetTopMenus(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetCancellationRates(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetDeliveryMix(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterAnalyticsRoutes(router Router, h AnalyticsHandler) {
+	// 구간별 매출 집계 API
+	router.AddRoute(Route{
+		Path:     "/admin/analytics/sales",
+		Method:   "GET",
+		Handler:  h.GetSalesAnalytics,
+		AuthType: SessionAuth,
+	})
+
+	// 매출 상위 매장 API
+	router.AddRoute(Route{
+		Path:     "/admin/analytics/top-restaurants",
+		Method:   "GET",
+		Handler:  h.GetTopRestaurants,
+		AuthType: SessionAuth,
+	})
+
+	// 매출 상위 메뉴 API
+	router.AddRoute(Route{
+		Path:     "/admin/analytics/top-menus",
+		Method:   "GET",
+		Handler:  h.GetTopMenus,
+		AuthType: SessionAuth,
+	})
+
+	// 매장별 취소/거절 비율 API
+	router.AddRoute(Route{
+		Path:     "/admin/analytics/cancellations",
+		Method:   "GET",
+		Handler:  h.GetCancellationRates,
+		AuthType: SessionAuth,
+	})
+
+	// 배달 유형별 비중 API
+	router.AddRoute(Route{
+		Path:     "/admin/analytics/delivery-mix",
+		Method:   "GET",
+		Handler:  h.GetDeliveryMix,
+		AuthType: SessionAuth,
+	})
+}
+
+// UserHandler는 사용자 관리 관련 핸들러 인터페이스
+type UserHandler interface {
+	GetUsers(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	GetUser(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	SuspendUser(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	UnsuspendUser(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ChangeUserRole(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterUserRoutes(router Router, h UserHandler) {
+	// 사용자 목록 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/user",
+		Method:   "GET",
+		Handler:  h.GetUsers,
+		AuthType: SessionAuth,
+	})
+
+	// 사용자 상세 조회 API
+	router.AddRoute(Route{
+		Path:     "/admin/user/{id}",
+		Method:   "GET",
+		Handler:  h.GetUser,
+		AuthType: SessionAuth,
+	})
+
+	// 사용자 계정 정지 API
+	router.AddRoute(Route{
+		Path:     "/admin/user/{id}/suspend",
+		Method:   "POST",
+		Handler:  h.SuspendUser,
+		AuthType: SessionAuth,
+	})
+
+	// 사용자 계정 정지 해제 API
+	router.AddRoute(Route{
+		Path:     "/admin/user/{id}/unsuspend",
+		Method:   "POST",
+		Handler:  h.UnsuspendUser,
+		AuthType: SessionAuth,
+	})
+
+	// 사용자 역할 변경 API (슈퍼 관리자 전용)
+	router.AddRoute(Route{
+		Path:     "/admin/user/{id}/role",
+		Method:   "PUT",
+		Handler:  h.ChangeUserRole,
+		AuthType: SessionAuth,
+	})
+}
+
+// ExportHandler는 목록 내보내기 관련 핸들러 인터페이스
+type ExportHandler interface {
+	ExportRestaurantRequests(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ExportRestaurants(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ExportOrders(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterExportRoutes(router Router, h ExportHandler) {
+	// 매장 요청 목록 내보내기 API
+	router.AddRoute(Route{
+		Path:     "/admin/export/restaurant-request",
+		Method:   "GET",
+		Handler:  h.ExportRestaurantRequests,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 목록 내보내기 API
+	router.AddRoute(Route{
+		Path:     "/admin/export/restaurant",
+		Method:   "GET",
+		Handler:  h.ExportRestaurants,
+		AuthType: SessionAuth,
+	})
+
+	// 주문 목록 내보내기 API
+	router.AddRoute(Route{
+		Path:     "/admin/export/order",
+		Method:   "GET",
+		Handler:  h.ExportOrders,
+		AuthType: SessionAuth,
+	})
+}
+
+// ImportHandler는 매장 일괄 등록 관련 핸들러 인터페이스
+type ImportHandler interface {
+	CreateRestaurantImportUploadURL(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ImportRestaurants(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+func RegisterImportRoutes(router Router, h ImportHandler) {
+	// 매장 일괄 등록 CSV 업로드 URL 발급 API
+	router.AddRoute(Route{
+		Path:     "/admin/import/restaurant/upload-url",
+		Method:   "POST",
+		Handler:  h.CreateRestaurantImportUploadURL,
+		AuthType: SessionAuth,
+	})
+
+	// 매장 일괄 등록 API (dryRun 지원)
+	router.AddRoute(Route{
+		Path:     "/admin/import/restaurant",
+		Method:   "POST",
+		Handler:  h.ImportRestaurants,
+		AuthType: SessionAuth,
 	})
 }
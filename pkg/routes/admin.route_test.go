@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"strings"
+	"testing"
+
+	handler "lambda-go/pkg/handlers"
+	adminHandler "lambda-go/pkg/handlers/admin"
+)
+
+// TestAdminRoutesRequireSession은 관리자 라우트가 모두 세션 인증을 거치는지 확인합니다.
+// 상태 전이 API(재검토, 철회, 매장 상태 변경)는 감사 로그에 행위자가 남아야 하므로 특히 빠지면 안 됩니다.
+func TestAdminRoutesRequireSession(t *testing.T) {
+	r := NewRouter().(*router)
+	h := &adminHandler.AdminHandler{Handler: &handler.Handler{}}
+
+	RegisterAdminRoutes(r, h)
+	RegisterMenuRoutes(r, h)
+	RegisterAuditRoutes(r, h)
+	RegisterRejectReasonRoutes(r, h)
+	RegisterTagRoutes(r, h)
+	RegisterOrderRoutes(r, h)
+	RegisterAnalyticsRoutes(r, h)
+	RegisterUserRoutes(r, h)
+	RegisterExportRoutes(r, h)
+	RegisterImportRoutes(r, h)
+
+	registered := map[string]AuthType{}
+	for _, route := range r.routes {
+		if !strings.HasPrefix(route.Path, "/admin/") {
+			t.Errorf("%s %s: 관리자 라우트가 아닙니다", route.Method, route.Path)
+		}
+		if route.AuthType != SessionAuth {
+			t.Errorf("%s %s: AuthType = %d, want SessionAuth", route.Method, route.Path, route.AuthType)
+		}
+		registered[route.Method+" "+route.Path] = route.AuthType
+	}
+
+	for _, route := range []string{
+		"POST /admin/restaurant/request/{id}/reopen",
+		"POST /admin/restaurant/request/{id}/withdraw",
+		"POST /admin/restaurant/{id}/status",
+	} {
+		if _, ok := registered[route]; !ok {
+			t.Errorf("%s 라우트가 등록되지 않았습니다", route)
+		}
+	}
+}
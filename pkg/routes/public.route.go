@@ -9,14 +9,23 @@ import (
 // S3Handler는 S3 관련 핸들러 인터페이스
 type S3Handler interface {
 	GetPresignedURL(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	ConfirmUpload(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
 }
 
 func RegisterPublicRoutes(router Router, h S3Handler) {
-	// 업로드용 Presigned URL 발급 API
+	// 업로드용 Presigned URL 발급 API (역할별 키 접두사 정책 적용)
 	router.AddRoute(Route{
 		Path:     "/s3/presigned-url",
 		Method:   "GET",
 		Handler:  h.GetPresignedURL,
-		AuthType: NoAuth,
+		AuthType: DefaultAuth,
+	})
+
+	// 업로드 확인 API (객체 크기와 ETag를 등록부에 기록)
+	router.AddRoute(Route{
+		Path:     "/s3/upload/{id}/confirm",
+		Method:   "POST",
+		Handler:  h.ConfirmUpload,
+		AuthType: DefaultAuth,
 	})
 }
@@ -95,8 +95,9 @@ func (r *router) Handle(ctx context.Context, request events.APIGatewayProxyReque
 		methodMatches := route.Method == "" || route.Method == request.HTTPMethod
 
 		if pathMatches && methodMatches {
-			// 파라미터를 컨텍스트에 저장
+			// 파라미터와 요청 메타데이터를 컨텍스트에 저장
 			paramCtx := context.WithValue(ctx, appCtx.ParamsKey, params)
+			paramCtx = appCtx.WithRequestMeta(paramCtx, appCtx.NewRequestMeta(request))
 
 			// 인증 방식에 따라 처리
 			switch route.AuthType {
@@ -131,11 +132,20 @@ func SetupRouter(
 	ctx context.Context,
 	cfg *config.Config,
 	s3Svc *publicService.S3Service,
+	uploadSvc *publicService.UploadService,
 	adminSvc *adminService.RestaurantService,
+	auditSvc *adminService.AuditService,
+	rejectReasonSvc *adminService.RejectReasonService,
+	tagSvc *adminService.TagService,
+	orderSvc *adminService.OrderService,
+	analyticsSvc *adminService.AnalyticsService,
+	userSvc *adminService.UserService,
+	exportSvc *adminService.ExportService,
+	importSvc *adminService.ImportService,
 	db *sql.DB,
 ) (Router, func(context.Context, events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, *utils.AppError)) {
 	// 기본 핸들러 생성
-	h := handler.NewHandler(cfg, s3Svc, adminSvc)
+	h := handler.NewHandler(cfg, s3Svc, uploadSvc, adminSvc, auditSvc, rejectReasonSvc, tagSvc, orderSvc, analyticsSvc, userSvc, exportSvc, importSvc)
 
 	// 도메인별 핸들러 생성
 	adminHandler := &adminHandler.AdminHandler{Handler: h}
@@ -146,6 +156,15 @@ func SetupRouter(
 
 	// 라우트 등록
 	RegisterAdminRoutes(router, adminHandler)
+	RegisterMenuRoutes(router, adminHandler)
+	RegisterAuditRoutes(router, adminHandler)
+	RegisterRejectReasonRoutes(router, adminHandler)
+	RegisterTagRoutes(router, adminHandler)
+	RegisterOrderRoutes(router, adminHandler)
+	RegisterAnalyticsRoutes(router, adminHandler)
+	RegisterUserRoutes(router, adminHandler)
+	RegisterExportRoutes(router, adminHandler)
+	RegisterImportRoutes(router, adminHandler)
 	RegisterPublicRoutes(router, s3Handler)
 
 	// 핸들러 함수 반환
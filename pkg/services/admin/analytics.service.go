@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	repository "lambda-go/pkg/repositories"
+	cacheService "lambda-go/pkg/services/cache"
+	"lambda-go/pkg/utils"
+)
+
+// AnalyticsService는 관리자 매출/주문 분석 서비스를 제공합니다.
+// 집계 결과는 설정된 캐시에 CacheTTL 동안 보관하며, generatedAt으로 집계 시각을 알려줍니다.
+type AnalyticsService struct {
+	config        *config.Config
+	analyticsCfg  *config.AnalyticsConfig
+	analyticsRepo *repository.AnalyticsRepository
+	cache         cacheService.Cache
+}
+
+// NewAnalyticsService는 새 AnalyticsService 인스턴스를 생성합니다.
+func NewAnalyticsService(cfg *config.Config, analyticsCfg *config.AnalyticsConfig, analyticsRepo *repository.AnalyticsRepository, cache cacheService.Cache) *AnalyticsService {
+	return &AnalyticsService{
+		config:        cfg,
+		analyticsCfg:  analyticsCfg,
+		analyticsRepo: analyticsRepo,
+		cache:         cache,
+	}
+}
+
+// cachedAnalytics는 캐시된 집계 결과를 반환하고, 없으면 load로 집계한 뒤 캐시에 보관합니다.
+// 캐시 오류는 집계를 실패시키지 않고 로그만 남깁니다.
+func cachedAnalytics[T any](ctx context.Context, s *AnalyticsService, name string, query dto.AnalyticsQuery, load func() (*T, error)) (*T, error) {
+	keyData, _ := json.Marshal(query)
+	key := "analytics:" + name + ":" + string(keyData)
+
+	data, ok, err := s.cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("분석 캐시 조회 실패 (%s): %v", s.cache.Name(), err)
+	} else if ok {
+		var cached T
+		if err := json.Unmarshal(data, &cached); err == nil {
+			return &cached, nil
+		}
+	}
+
+	result, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err := json.Marshal(result); err == nil {
+		if err := s.cache.Set(ctx, key, data, s.analyticsCfg.CacheTTL); err != nil {
+			log.Printf("분석 캐시 저장 실패 (%s): %v", s.cache.Name(), err)
+		}
+	}
+	return result, nil
+}
+
+// prepareQuery는 기간 기본값을 채우고 조회 기간이 올바른지 확인합니다.
+// to가 없으면 내일 0시(Asia/Seoul), from이 없으면 to로부터 DefaultDays일 전입니다.
+func (s *AnalyticsService) prepareQuery(query dto.AnalyticsQuery) (dto.AnalyticsQuery, error) {
+	if query.To.IsZero() {
+		query.To = models.INTERVAL_DAY.Truncate(time.Now()).AddDate(0, 0, 1)
+	}
+	if query.From.IsZero() {
+		query.From = query.To.AddDate(0, 0, -s.analyticsCfg.DefaultDays)
+	}
+	if !query.From.Before(query.To) {
+		return query, utils.BadRequest("from은 to보다 이전이어야 합니다")
+	}
+	query.From, query.To = query.From.In(models.SeoulLocation), query.To.In(models.SeoulLocation)
+	return query, nil
+}
+
+// GetSales는 구간별 주문 수와 GMV를 조회합니다. 주문이 없는 구간도 0으로 채워 반환합니다.
+func (s *AnalyticsService) GetSales(ctx context.Context, query dto.AnalyticsQuery) (*models.SalesAnalyticsResponse, error) {
+	query, err := s.prepareQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	starts, err := query.Interval.Buckets(query.From, query.To)
+	if err != nil {
+		return nil, utils.BadRequest(err.Error())
+	}
+
+	return cachedAnalytics(ctx, s, "sales", query, func() (*models.SalesAnalyticsResponse, error) {
+		found, err := s.analyticsRepo.GetSalesBuckets(ctx, query)
+		if err != nil {
+			return nil, utils.InternalServerError("매출 집계 조회 실패", err)
+		}
+
+		response := &models.SalesAnalyticsResponse{
+			From:        query.From,
+			To:          query.To,
+			Interval:    query.Interval,
+			Timezone:    models.SeoulLocation.String(),
+			Buckets:     make([]models.SalesBucket, 0, len(starts)),
+			GeneratedAt: time.Now(),
+		}
+		for _, start := range starts {
+			date := start.Format("2006-01-02")
+			bucket, ok := found[date]
+			if !ok {
+				bucket = models.SalesBucket{Date: date}
+			}
+			response.Buckets = append(response.Buckets, bucket)
+			response.TotalOrders += bucket.Orders
+			response.TotalGMV += bucket.GMV
+		}
+		return response, nil
+	})
+}
+
+// GetTopRestaurants는 매장 순위를 조회합니다.
+func (s *AnalyticsService) GetTopRestaurants(ctx context.Context, query dto.AnalyticsQuery) (*models.AnalyticsRankingResponse[models.TopRestaurant], error) {
+	query, err := s.prepareQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return cachedAnalytics(ctx, s, "top-restaurants", query, func() (*models.AnalyticsRankingResponse[models.TopRestaurant], error) {
+		items, err := s.analyticsRepo.GetTopRestaurants(ctx, query)
+		if err != nil {
+			return nil, utils.InternalServerError("매장 순위 조회 실패", err)
+		}
+		return rankingResponse(query, items), nil
+	})
+}
+
+// GetTopMenus는 메뉴 순위를 조회합니다. restaurantId를 지정하면 해당 매장 메뉴만 집계합니다.
+func (s *AnalyticsService) GetTopMenus(ctx context.Context, query dto.AnalyticsQuery) (*models.AnalyticsRankingResponse[models.TopMenu], error) {
+	query, err := s.prepareQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return cachedAnalytics(ctx, s, "top-menus", query, func() (*models.AnalyticsRankingResponse[models.TopMenu], error) {
+		items, err := s.analyticsRepo.GetTopMenus(ctx, query)
+		if err != nil {
+			return nil, utils.InternalServerError("메뉴 순위 조회 실패", err)
+		}
+		return rankingResponse(query, items), nil
+	})
+}
+
+// GetCancellationRates는 매장별 취소/거절 비율을 조회합니다.
+func (s *AnalyticsService) GetCancellationRates(ctx context.Context, query dto.AnalyticsQuery) (*models.AnalyticsRankingResponse[models.RestaurantCancellation], error) {
+	query, err := s.prepareQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return cachedAnalytics(ctx, s, "cancellations", query, func() (*models.AnalyticsRankingResponse[models.RestaurantCancellation], error) {
+		items, err := s.analyticsRepo.GetCancellationRates(ctx, query)
+		if err != nil {
+			return nil, utils.InternalServerError("취소/거절 비율 조회 실패", err)
+		}
+		return rankingResponse(query, items), nil
+	})
+}
+
+// GetDeliveryMix는 배달 유형별 주문 비중을 조회합니다.
+func (s *AnalyticsService) GetDeliveryMix(ctx context.Context, query dto.AnalyticsQuery) (*models.AnalyticsRankingResponse[models.DeliveryTypeShare], error) {
+	query, err := s.prepareQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return cachedAnalytics(ctx, s, "delivery-mix", query, func() (*models.AnalyticsRankingResponse[models.DeliveryTypeShare], error) {
+		items, err := s.analyticsRepo.GetDeliveryMix(ctx, query)
+		if err != nil {
+			return nil, utils.InternalServerError("배달 유형 비중 조회 실패", err)
+		}
+		return rankingResponse(query, items), nil
+	})
+}
+
+func rankingResponse[T any](query dto.AnalyticsQuery, items []T) *models.AnalyticsRankingResponse[T] {
+	return &models.AnalyticsRankingResponse[T]{
+		From:        query.From,
+		To:          query.To,
+		Items:       items,
+		GeneratedAt: time.Now(),
+	}
+}
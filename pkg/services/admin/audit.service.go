@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	repository "lambda-go/pkg/repositories"
+	"lambda-go/pkg/utils"
+)
+
+// AuditService는 감사 로그 조회 서비스를 제공합니다.
+type AuditService struct {
+	config    *config.Config
+	auditRepo *repository.AuditRepository
+}
+
+// NewAuditService는 새 AuditService 인스턴스를 생성합니다.
+func NewAuditService(cfg *config.Config, auditRepo *repository.AuditRepository) *AuditService {
+	return &AuditService{
+		config:    cfg,
+		auditRepo: auditRepo,
+	}
+}
+
+// GetAuditLogs는 감사 로그를 커서 기반으로 조회합니다.
+func (s *AuditService) GetAuditLogs(ctx context.Context, query dto.AuditLogQuery) (*models.AuditLogsResponse, error) {
+	// 다음 페이지 존재 여부 확인을 위해 한 건 더 조회
+	limit := query.Limit
+	query.Limit = limit + 1
+
+	logs, err := s.auditRepo.List(ctx, query)
+	if err != nil {
+		return nil, utils.InternalServerError("감사 로그 조회 실패", err)
+	}
+
+	resp := &models.AuditLogsResponse{Logs: logs}
+	if len(logs) > limit {
+		resp.Logs = logs[:limit]
+		cursor := EncodeAuditCursor(resp.Logs[limit-1].ID)
+		resp.NextCursor = &cursor
+	}
+
+	return resp, nil
+}
+
+// EncodeAuditCursor는 로그 ID를 불투명 커서 문자열로 변환합니다.
+func EncodeAuditCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+// DecodeAuditCursor는 커서 문자열을 로그 ID로 변환합니다.
+func DecodeAuditCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(raw), 10, 64)
+}
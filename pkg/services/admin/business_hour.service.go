@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// GetBusinessSchedule은 매장의 영업 일정과 주어진 시각(Asia/Seoul 기준)의 영업 여부를 조회합니다.
+func (s *RestaurantService) GetBusinessSchedule(ctx context.Context, restaurantID string, at time.Time) (*models.BusinessSchedule, error) {
+	if _, err := s.restaurantRepo.GetRestaurantByID(ctx, restaurantID); err != nil {
+		return nil, repositoryError(err, "매장 조회 실패")
+	}
+
+	schedule, err := s.restaurantRepo.GetBusinessSchedule(ctx, restaurantID)
+	if err != nil {
+		return nil, utils.InternalServerError("영업 일정 조회 실패", err)
+	}
+
+	schedule.OpenStatus = &models.OpenStatus{
+		At:     at.In(models.SeoulLocation),
+		IsOpen: schedule.IsOpenAt(at),
+	}
+	return schedule, nil
+}
+
+// ReplaceBusinessSchedule은 매장의 주간 영업 시간, 정기 휴무일, 예외 날짜를 한 번에 교체합니다.
+// 검증에 실패하면 아무것도 바꾸지 않으며, 교체 전후 일정을 감사 로그에 남깁니다.
+func (s *RestaurantService) ReplaceBusinessSchedule(ctx context.Context, actor models.AuditActor, restaurantID string, payload *models.ReplaceBusinessScheduleRequest) (*models.BusinessSchedule, error) {
+	schedule := newBusinessSchedule(restaurantID, payload)
+	if err := schedule.Validate(); err != nil {
+		return nil, utils.BadRequest(err.Error(), err)
+	}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		restaurant, err := s.restaurantRepo.LockRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return repositoryError(err, "매장 조회 실패")
+		}
+
+		if restaurant.DeletedAt != nil {
+			return utils.Conflict("삭제된 매장의 영업 일정은 수정할 수 없습니다")
+		}
+
+		before, err := s.restaurantRepo.FindBusinessSchedule(ctx, tx, restaurantID)
+		if err != nil {
+			return utils.InternalServerError("영업 일정 조회 실패", err)
+		}
+
+		if err := s.restaurantRepo.ReplaceBusinessSchedule(ctx, tx, schedule); err != nil {
+			return utils.InternalServerError("영업 일정 저장 실패", err)
+		}
+
+		return s.writeAudit(ctx, tx, actor, models.AUDIT_BUSINESS_SCHEDULE_UPDATE, models.AUDIT_TARGET_RESTAURANT, restaurantID, before, schedule, payload.Reason)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "영업 일정 저장 실패")
+	}
+
+	now := time.Now()
+	schedule.OpenStatus = &models.OpenStatus{
+		At:     now.In(models.SeoulLocation),
+		IsOpen: schedule.IsOpenAt(now),
+	}
+	return schedule, nil
+}
+
+// newBusinessSchedule은 교체 페이로드를 영업 일정 모델로 변환합니다.
+func newBusinessSchedule(restaurantID string, payload *models.ReplaceBusinessScheduleRequest) *models.BusinessSchedule {
+	schedule := &models.BusinessSchedule{
+		RestaurantID: restaurantID,
+		Timezone:     models.SeoulLocation.String(),
+		Hours:        make([]models.BusinessHour, 0, len(payload.Hours)),
+		Holidays:     make([]models.RestaurantHoliday, 0, len(payload.Holidays)),
+		Exceptions:   make([]models.BusinessHourException, 0, len(payload.Exceptions)),
+	}
+
+	for _, hour := range payload.Hours {
+		schedule.Hours = append(schedule.Hours, models.BusinessHour{
+			RestaurantID: restaurantID,
+			DayOfWeek:    hour.DayOfWeek,
+			OpenTime:     hour.OpenTime,
+			CloseTime:    hour.CloseTime,
+		})
+	}
+
+	for _, holiday := range payload.Holidays {
+		schedule.Holidays = append(schedule.Holidays, models.RestaurantHoliday{
+			RestaurantID: restaurantID,
+			DayOfWeek:    holiday.DayOfWeek,
+			WeekOfMonth:  holiday.WeekOfMonth,
+		})
+	}
+
+	for _, exception := range payload.Exceptions {
+		schedule.Exceptions = append(schedule.Exceptions, models.BusinessHourException{
+			RestaurantID: restaurantID,
+			Date:         exception.Date,
+			Closed:       exception.Closed,
+			OpenTime:     exception.OpenTime,
+			CloseTime:    exception.CloseTime,
+			Note:         exception.Note,
+		})
+	}
+
+	return schedule
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// ClaimRestaurantRequest는 검토 대기 중인 매장 요청을 TTL 동안 점유합니다.
+// 본인이 이미 점유한 요청이면 만료 시간을 연장합니다.
+func (s *RestaurantService) ClaimRestaurantRequest(ctx context.Context, actor models.AuditActor, requestID string, payload *models.ClaimRestaurantRequest) (*models.RestaurantRequest, error) {
+	ttl := s.config.ClaimTTL
+	if payload.TTLMinutes > 0 {
+		ttl = time.Duration(payload.TTLMinutes) * time.Minute
+	}
+	if ttl > s.config.MaxClaimTTL {
+		return nil, utils.BadRequest(fmt.Sprintf("점유 시간은 최대 %d분입니다", int(s.config.MaxClaimTTL.Minutes())))
+	}
+
+	var result *models.RestaurantRequest
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		current, err := s.restaurantRepo.LockRestaurantRequest(ctx, tx, requestID)
+		if err != nil {
+			return repositoryError(err, "매장 요청 조회 실패")
+		}
+
+		if current.Status != models.PENDING {
+			return utils.Conflict(fmt.Sprintf("검토 대기 중인 요청만 점유할 수 있습니다 (현재 상태: %s)", current.Status))
+		}
+
+		if err := s.checkClaim(actor, current, payload.Force); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		expiresAt := now.Add(ttl)
+		assigneeID := actor.UserID
+
+		if err := s.restaurantRepo.UpdateRestaurantRequestClaim(ctx, tx, requestID, &assigneeID, &now, &expiresAt); err != nil {
+			return repositoryError(err, "매장 요청 점유 실패")
+		}
+
+		before := *current
+		result = current
+		result.AssigneeID = &assigneeID
+		result.ClaimedAt = &now
+		result.ClaimExpiresAt = &expiresAt
+
+		return s.writeAudit(ctx, tx, actor, models.AUDIT_REQUEST_CLAIM, models.AUDIT_TARGET_RESTAURANT_REQUEST, requestID, &before, result, nil)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 요청 점유 실패")
+	}
+
+	return result, nil
+}
+
+// ReleaseRestaurantRequest는 매장 요청의 점유를 해제합니다.
+// 점유되지 않은 요청이면 아무것도 하지 않습니다.
+func (s *RestaurantService) ReleaseRestaurantRequest(ctx context.Context, actor models.AuditActor, requestID string, payload *models.ReleaseRestaurantRequest) (*models.RestaurantRequest, error) {
+	var result *models.RestaurantRequest
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		current, err := s.restaurantRepo.LockRestaurantRequest(ctx, tx, requestID)
+		if err != nil {
+			return repositoryError(err, "매장 요청 조회 실패")
+		}
+
+		result = current
+		if current.AssigneeID == nil {
+			return nil
+		}
+
+		if err := s.checkClaim(actor, current, payload.Force); err != nil {
+			return err
+		}
+
+		if err := s.restaurantRepo.UpdateRestaurantRequestClaim(ctx, tx, requestID, nil, nil, nil); err != nil {
+			return repositoryError(err, "매장 요청 점유 해제 실패")
+		}
+
+		before := *current
+		result.AssigneeID = nil
+		result.ClaimedAt = nil
+		result.ClaimExpiresAt = nil
+
+		return s.writeAudit(ctx, tx, actor, models.AUDIT_REQUEST_RELEASE, models.AUDIT_TARGET_RESTAURANT_REQUEST, requestID, &before, result, nil)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 요청 점유 해제 실패")
+	}
+
+	return result, nil
+}
+
+// checkClaim은 다른 검토자가 요청을 점유 중인지 확인합니다.
+// 슈퍼 관리자는 override로 점유를 무시할 수 있습니다.
+func (s *RestaurantService) checkClaim(actor models.AuditActor, request *models.RestaurantRequest, override bool) error {
+	if !request.IsClaimedByOther(actor.UserID, time.Now()) {
+		return nil
+	}
+
+	if override {
+		if !s.config.IsSuperAdmin(actor.UserID) {
+			return utils.Forbidden("점유 무시는 슈퍼 관리자만 할 수 있습니다")
+		}
+		return nil
+	}
+
+	return utils.Conflict(fmt.Sprintf("다른 검토자(%s)가 %s까지 점유 중인 요청입니다",
+		*request.AssigneeID, request.ClaimExpiresAt.Format(time.RFC3339)))
+}
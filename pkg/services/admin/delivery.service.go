@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// GetInFlightDeliveries는 배달 중인 주문 배달을 경과 시간, 예상 시간 초과 여부와 함께 조회합니다.
+func (s *OrderService) GetInFlightDeliveries(ctx context.Context, query dto.DeliveryQuery) (*models.InFlightDeliveriesResponse, error) {
+	deliveries, total, err := s.orderRepo.GetInFlightDeliveries(ctx, query, time.Now())
+	if err != nil {
+		return nil, utils.InternalServerError("배달 목록 조회 실패", err)
+	}
+
+	return &models.InFlightDeliveriesResponse{
+		Deliveries: deliveries,
+		Pagination: models.Pagination{
+			Total:      total,
+			Page:       query.Page,
+			PageSize:   query.PageSize,
+			TotalPages: (total + query.PageSize - 1) / query.PageSize,
+		},
+	}, nil
+}
+
+// CorrectDeliveryStatus는 잘못 기록된 주문 배달 상태를 정정하고 사유를 감사 로그에 남깁니다.
+// 정정이므로 이전 상태로 되돌리는 것도 허용하지만, 픽업 주문이나 취소/거절된 주문은 배달 중으로 바꿀 수 없습니다.
+func (s *OrderService) CorrectDeliveryStatus(ctx context.Context, actor models.AuditActor, orderID string, payload *models.CorrectDeliveryStatusRequest) (*models.OrderDelivery, error) {
+	var delivery *models.OrderDelivery
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		order, err := s.orderRepo.LockOrder(ctx, tx, orderID)
+		if err != nil {
+			return repositoryError(err, "주문 조회 실패")
+		}
+
+		delivery, err = s.orderRepo.LockOrderDelivery(ctx, tx, orderID)
+		if err != nil {
+			return repositoryError(err, "배달 정보 조회 실패")
+		}
+
+		if delivery.Status == payload.Status {
+			return utils.Conflict(fmt.Sprintf("이미 %s 상태입니다", payload.Status))
+		}
+		if payload.Status == models.DELIVERING {
+			if delivery.Type != nil && *delivery.Type == models.PICKUP {
+				return utils.BadRequest("픽업 주문은 배달 중 상태로 바꿀 수 없습니다")
+			}
+			if order.Status == models.ORDER_CANCELLED || order.Status == models.ORDER_REJECTED {
+				return utils.Conflict(fmt.Sprintf("%s 상태인 주문은 배달 중 상태로 바꿀 수 없습니다", order.Status))
+			}
+		}
+
+		updatedAt, err := s.orderRepo.UpdateDeliveryStatus(ctx, tx, delivery.ID, delivery.Status, payload.Status)
+		if err != nil {
+			return repositoryError(err, "배달 상태 변경 실패")
+		}
+
+		before := *delivery
+		delivery.Status = payload.Status
+		delivery.UpdatedAt = updatedAt
+
+		return writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_DELIVERY_STATUS_CORRECT, models.AUDIT_TARGET_ORDER, orderID, &before, delivery, &payload.Reason)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "배달 상태 변경 실패")
+	}
+
+	return delivery, nil
+}
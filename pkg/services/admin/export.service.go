@@ -0,0 +1,266 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	repository "lambda-go/pkg/repositories"
+	publicService "lambda-go/pkg/services/public"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// exportPageSize는 내보내기 시 목록을 나누어 조회하는 페이지 크기입니다.
+const exportPageSize = 500
+
+// ExportService는 관리자 목록을 CSV/XLSX 파일로 내보내는 서비스를 제공합니다.
+type ExportService struct {
+	config         *config.Config
+	exportCfg      *config.ExportConfig
+	txManager      *repository.TxManager
+	restaurantRepo *repository.RestaurantRepository
+	orderRepo      *repository.OrderRepository
+	s3Service      *publicService.S3Service
+}
+
+// NewExportService는 새 ExportService 인스턴스를 생성합니다.
+func NewExportService(cfg *config.Config, exportCfg *config.ExportConfig, txManager *repository.TxManager, restaurantRepo *repository.RestaurantRepository, orderRepo *repository.OrderRepository, s3Svc *publicService.S3Service) *ExportService {
+	return &ExportService{
+		config:         cfg,
+		exportCfg:      exportCfg,
+		txManager:      txManager,
+		restaurantRepo: restaurantRepo,
+		orderRepo:      orderRepo,
+		s3Service:      s3Svc,
+	}
+}
+
+// exportSource는 내보내기 스냅샷 트랜잭션 안에서 목록을 세고 키셋으로 나누어 조회합니다.
+// fetch는 last(직전 페이지의 마지막 항목, 첫 페이지는 nil) 다음부터 ID 순으로 최대 limit건을 반환합니다.
+type exportSource[T any] struct {
+	count func(tx pgx.Tx) (int, error)
+	fetch func(tx pgx.Tx, last *T, limit int) ([]T, error)
+}
+
+// ExportRestaurantRequests는 매장 요청 목록을 내보냅니다.
+func (s *ExportService) ExportRestaurantRequests(ctx context.Context, query dto.RestaurantRequestQuery, options dto.ExportOptions) (*models.ExportResult, error) {
+	now := time.Now()
+	return exportList(ctx, s, "restaurant_requests", "매장 요청", models.RestaurantRequestExportColumns, options, exportSource[models.RestaurantRequest]{
+		count: func(tx pgx.Tx) (int, error) {
+			return s.restaurantRepo.CountRestaurantRequestsForExport(ctx, tx, query, now)
+		},
+		fetch: func(tx pgx.Tx, last *models.RestaurantRequest, limit int) ([]models.RestaurantRequest, error) {
+			afterID := 0
+			if last != nil {
+				afterID = last.ID
+			}
+			return s.restaurantRepo.GetRestaurantRequestsForExport(ctx, tx, query, now, afterID, limit)
+		},
+	})
+}
+
+// ExportRestaurants는 매장 목록을 내보냅니다.
+func (s *ExportService) ExportRestaurants(ctx context.Context, query dto.RestaurantQuery, options dto.ExportOptions) (*models.ExportResult, error) {
+	return exportList(ctx, s, "restaurants", "매장", models.RestaurantExportColumns, options, exportSource[models.Restaurant]{
+		count: func(tx pgx.Tx) (int, error) {
+			return s.restaurantRepo.CountRestaurantsForExport(ctx, tx, query)
+		},
+		fetch: func(tx pgx.Tx, last *models.Restaurant, limit int) ([]models.Restaurant, error) {
+			afterID := ""
+			if last != nil {
+				afterID = last.ID
+			}
+			return s.restaurantRepo.GetRestaurantsForExport(ctx, tx, query, afterID, limit)
+		},
+	})
+}
+
+// ExportOrders는 주문 목록을 내보냅니다.
+func (s *ExportService) ExportOrders(ctx context.Context, query dto.OrderQuery, options dto.ExportOptions) (*models.ExportResult, error) {
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, utils.BadRequest("from은 to보다 이전이어야 합니다")
+	}
+
+	return exportList(ctx, s, "orders", "주문", models.OrderExportColumns, options, exportSource[models.Order]{
+		count: func(tx pgx.Tx) (int, error) {
+			return s.orderRepo.CountOrdersForExport(ctx, tx, query)
+		},
+		fetch: func(tx pgx.Tx, last *models.Order, limit int) ([]models.Order, error) {
+			afterID := ""
+			if last != nil {
+				afterID = last.ID
+			}
+			return s.orderRepo.GetOrdersForExport(ctx, tx, query, afterID, limit)
+		},
+	})
+}
+
+// exportList는 읽기 전용 REPEATABLE READ 스냅샷 안에서 목록을 세고 ID 키셋으로 나누어 조회하며 파일을 씁니다.
+// 행 수가 InlineMaxRows 이하인 CSV는 메모리에서 만들어 바로 응답하고,
+// XLSX나 큰 파일은 행을 모으지 않고 S3 멀티파트 업로드로 흘려 보낸 뒤 다운로드 URL을 반환합니다.
+func exportList[T any](ctx context.Context, s *ExportService, name, sheetName string, allColumns []models.ExportColumn[T], options dto.ExportOptions, source exportSource[T]) (*models.ExportResult, error) {
+	columns, err := models.SelectExportColumns(allColumns, options.Columns)
+	if err != nil {
+		return nil, utils.BadRequest(err.Error())
+	}
+
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.Header
+	}
+	fileName := fmt.Sprintf("%s_%s.%s", name, time.Now().In(models.SeoulLocation).Format("20060102_150405"), options.Format)
+
+	var result models.ExportResult
+	err = s.txManager.RunInReadOnlySnapshot(ctx, func(tx pgx.Tx) error {
+		total, err := source.count(tx)
+		if err != nil {
+			return utils.InternalServerError("내보낼 목록 조회 실패", err)
+		}
+		if total > s.exportCfg.MaxRows {
+			return utils.BadRequest(fmt.Sprintf("내보낼 행이 너무 많습니다 (%d행, 최대 %d행). 필터로 범위를 좁혀주세요", total, s.exportCfg.MaxRows))
+		}
+
+		write := func(w io.Writer) (int, error) {
+			return writeExportRows(tx, w, options.Format, sheetName, headers, columns, source.fetch)
+		}
+
+		// API Gateway 바이너리 응답 설정 없이 내려받을 수 있도록 XLSX는 항상 S3를 거칩니다
+		if options.Format == models.EXPORT_CSV && !options.Download && total <= s.exportCfg.InlineMaxRows {
+			var buf bytes.Buffer
+			rows, err := write(&buf)
+			if err != nil {
+				return err
+			}
+			result.File = &models.ExportFile{
+				FileName:    fileName,
+				ContentType: options.Format.ContentType(),
+				Rows:        rows,
+				Body:        buf.Bytes(),
+			}
+			return nil
+		}
+
+		result.Download, err = s.upload(ctx, fileName, options.Format, write)
+		return err
+	})
+	if err != nil {
+		return nil, repositoryError(err, "내보내기 실패")
+	}
+	return &result, nil
+}
+
+// writeExportRows는 fetch로 키셋 페이지를 차례로 조회하며 w에 스프레드시트를 쓰고 데이터 행 수를 반환합니다.
+// 메모리에는 한 페이지만 유지합니다.
+func writeExportRows[T any](tx pgx.Tx, w io.Writer, format models.ExportFormat, sheetName string, headers []string, columns []models.ExportColumn[T], fetch func(tx pgx.Tx, last *T, limit int) ([]T, error)) (int, error) {
+	var writer utils.SpreadsheetWriter
+	var err error
+	if format == models.EXPORT_XLSX {
+		writer, err = utils.NewXLSXWriter(w, sheetName, headers)
+	} else {
+		writer, err = utils.NewCSVWriter(w, headers)
+	}
+	if err != nil {
+		return 0, utils.InternalServerError("내보내기 파일 생성 실패", err)
+	}
+
+	rows := 0
+	var last *T
+	for {
+		items, err := fetch(tx, last, exportPageSize)
+		if err != nil {
+			return 0, utils.InternalServerError("내보낼 목록 조회 실패", err)
+		}
+
+		for i := range items {
+			row := make([]string, len(columns))
+			for j, column := range columns {
+				row[j] = column.Value(&items[i])
+			}
+			if err := writer.WriteRow(row); err != nil {
+				return 0, utils.InternalServerError("내보내기 파일 생성 실패", err)
+			}
+		}
+		rows += len(items)
+
+		if len(items) < exportPageSize {
+			break
+		}
+		last = &items[len(items)-1]
+	}
+
+	if err := writer.Close(); err != nil {
+		return 0, utils.InternalServerError("내보내기 파일 생성 실패", err)
+	}
+	return rows, nil
+}
+
+// upload는 write가 만드는 내보내기 파일을 비공개 내보내기 버킷에 멀티파트로 올리고 presigned GET URL을 발급합니다.
+// 내보내기 파일에는 개인정보가 들어 있으므로 presigned 업로드 버킷에는 저장하지 않습니다.
+func (s *ExportService) upload(ctx context.Context, fileName string, format models.ExportFormat, write func(w io.Writer) (int, error)) (*models.ExportDownloadResponse, error) {
+	if s.exportCfg.Bucket == "" {
+		return nil, utils.InternalServerError("내보내기 버킷이 설정되지 않았습니다")
+	}
+	if s.exportCfg.Bucket == s.config.DefaultBucket {
+		return nil, utils.InternalServerError("내보내기 버킷은 업로드 버킷과 달라야 합니다")
+	}
+
+	id, err := utils.NewUUID()
+	if err != nil {
+		return nil, utils.InternalServerError("내보내기 파일 키 생성 실패", err)
+	}
+	key := fmt.Sprintf("%s%s/%s/%s", s.exportCfg.Prefix, time.Now().In(models.SeoulLocation).Format("2006-01-02"), id, fileName)
+
+	writer, err := s.s3Service.NewMultipartWriter(ctx, s.exportCfg.Bucket, key, format.ContentType(), fileName)
+	if err != nil {
+		return nil, utils.InternalServerError("파일 저장 실패", err)
+	}
+
+	rows, err := write(writer)
+	if err != nil {
+		writer.Abort()
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		writer.Abort()
+		return nil, utils.InternalServerError("파일 저장 실패", err)
+	}
+
+	return presignDownload(ctx, s.s3Service, s.exportCfg.Bucket, key, fileName, format, rows, s.exportCfg.URLExpiry)
+}
+
+// storeDownload는 서버에서 만든 파일을 S3에 저장하고 expiry 동안 유효한 presigned GET URL을 발급합니다.
+func storeDownload(ctx context.Context, s3Service *publicService.S3Service, bucket, key string, file *models.ExportFile, format models.ExportFormat, expiry time.Duration) (*models.ExportDownloadResponse, error) {
+	if err := s3Service.PutObject(ctx, bucket, key, file.Body, file.ContentType, file.FileName); err != nil {
+		return nil, utils.InternalServerError("파일 저장 실패", err)
+	}
+	return presignDownload(ctx, s3Service, bucket, key, file.FileName, format, file.Rows, expiry)
+}
+
+// presignDownload는 S3에 저장한 파일의 presigned GET URL을 발급합니다.
+func presignDownload(ctx context.Context, s3Service *publicService.S3Service, bucket, key, fileName string, format models.ExportFormat, rows int, expiry time.Duration) (*models.ExportDownloadResponse, error) {
+	presigned, err := s3Service.GeneratePresignedURL(ctx, &models.PresignedURLRequest{
+		Bucket:   bucket,
+		Key:      key,
+		Method:   "GET",
+		Duration: int64(expiry / time.Second),
+	})
+	if err != nil {
+		return nil, utils.InternalServerError("다운로드 URL 생성 실패", err)
+	}
+
+	return &models.ExportDownloadResponse{
+		URL:       presigned.URL,
+		Key:       key,
+		FileName:  fileName,
+		Format:    format,
+		Rows:      rows,
+		ExpiresAt: presigned.ExpiresAt,
+	}, nil
+}
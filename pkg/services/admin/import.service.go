@@ -0,0 +1,494 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	repository "lambda-go/pkg/repositories"
+	publicService "lambda-go/pkg/services/public"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// importKeyFolder는 매장 일괄 등록 CSV를 올리는 ImportConfig.Prefix 아래 폴더입니다.
+	importKeyFolder = "restaurants/"
+
+	importNameMaxLength    = 100
+	importAddressMaxLength = 200
+	importTextMaxLength    = 1000
+)
+
+// ImportService는 CSV 파일로 매장을 일괄 등록하는 서비스를 제공합니다.
+type ImportService struct {
+	config         *config.Config
+	importCfg      *config.ImportConfig
+	txManager      *repository.TxManager
+	restaurantRepo *repository.RestaurantRepository
+	userRepo       *repository.UserRepository
+	auditRepo      *repository.AuditRepository
+	s3Service      *publicService.S3Service
+}
+
+// NewImportService는 새 ImportService 인스턴스를 생성합니다.
+func NewImportService(cfg *config.Config, importCfg *config.ImportConfig, txManager *repository.TxManager, restaurantRepo *repository.RestaurantRepository, userRepo *repository.UserRepository, auditRepo *repository.AuditRepository, s3Svc *publicService.S3Service) *ImportService {
+	return &ImportService{
+		config:         cfg,
+		importCfg:      importCfg,
+		txManager:      txManager,
+		restaurantRepo: restaurantRepo,
+		userRepo:       userRepo,
+		auditRepo:      auditRepo,
+		s3Service:      s3Svc,
+	}
+}
+
+// restaurantImportRow는 검증을 통과한 CSV 한 행입니다.
+type restaurantImportRow struct {
+	row        int
+	restaurant models.Restaurant
+	business   models.RestaurantBusiness
+	hours      []models.BusinessHour
+}
+
+// CreateUploadURL은 가져올 CSV를 업로드할 presigned PUT URL을 발급합니다. 키는 서버에서 정합니다.
+func (s *ImportService) CreateUploadURL(ctx context.Context) (*models.RestaurantImportUploadResponse, error) {
+	if s.importCfg.Bucket == "" {
+		return nil, utils.InternalServerError("가져오기 버킷이 설정되지 않았습니다")
+	}
+
+	id, err := utils.NewUUID()
+	if err != nil {
+		return nil, utils.InternalServerError("업로드 키 생성 실패", err)
+	}
+	key := fmt.Sprintf("%s%s%s/%s.csv", s.importCfg.Prefix, importKeyFolder, time.Now().In(models.SeoulLocation).Format("2006-01-02"), id)
+
+	presigned, err := s.s3Service.GeneratePresignedURL(ctx, &models.PresignedURLRequest{
+		Bucket:      s.importCfg.Bucket,
+		Key:         key,
+		Method:      "PUT",
+		Duration:    int64(s.importCfg.URLExpiry / time.Second),
+		ContentType: "text/csv",
+		MaxFileSize: s.importCfg.MaxFileSize,
+	})
+	if err != nil {
+		return nil, utils.InternalServerError("업로드 URL 생성 실패", err)
+	}
+
+	return &models.RestaurantImportUploadResponse{
+		Key:         key,
+		URL:         presigned.URL,
+		Method:      "PUT",
+		ContentType: presigned.ContentType,
+		MaxFileSize: s.importCfg.MaxFileSize,
+		ExpiresAt:   presigned.ExpiresAt,
+	}, nil
+}
+
+// ImportRestaurants는 업로드한 CSV의 모든 행을 검증하고, 오류가 없으면 매장, 사업자 정보, 영업 시간을 한 트랜잭션으로 등록합니다.
+// 행 오류가 하나라도 있거나 dryRun이면 아무것도 저장하지 않고 검증 결과만 반환합니다.
+func (s *ImportService) ImportRestaurants(ctx context.Context, actor models.AuditActor, payload *models.ImportRestaurantsRequest) (*models.RestaurantImportReport, error) {
+	key := payload.Key
+	if !strings.HasPrefix(key, s.importCfg.Prefix+importKeyFolder) || strings.Contains(key, "..") || !strings.HasSuffix(key, ".csv") {
+		return nil, utils.BadRequest("업로드 URL 발급 API로 받은 키만 가져올 수 있습니다")
+	}
+
+	body, err := s.s3Service.GetObject(ctx, s.importCfg.Bucket, key, s.importCfg.MaxFileSize)
+	switch {
+	case errors.Is(err, publicService.ErrObjectNotFound):
+		return nil, utils.NotFound("업로드한 파일을 찾을 수 없습니다", err)
+	case errors.Is(err, publicService.ErrObjectTooLarge):
+		return nil, utils.BadRequest(fmt.Sprintf("파일이 최대 크기(%d바이트)를 넘습니다", s.importCfg.MaxFileSize), err)
+	case err != nil:
+		return nil, utils.InternalServerError("업로드한 파일 조회 실패", err)
+	}
+
+	records, err := s.readImportCSV(body)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.RestaurantImportReport{
+		Key:         key,
+		DryRun:      payload.DryRun,
+		TotalRows:   len(records),
+		Errors:      []models.ImportRowError{},
+		Restaurants: []models.ImportedRestaurant{},
+	}
+
+	rows := make([]restaurantImportRow, 0, len(records))
+	for _, record := range records {
+		row, rowErrors := parseRestaurantImportRow(record.row, record.values)
+		report.Errors = append(report.Errors, rowErrors...)
+		if len(rowErrors) == 0 {
+			rows = append(rows, row)
+		}
+	}
+
+	rows, err = s.checkImportReferences(ctx, rows, report)
+	if err != nil {
+		return nil, err
+	}
+	report.ValidRows = len(rows)
+
+	for _, row := range rows {
+		report.Restaurants = append(report.Restaurants, importedRestaurant(row))
+	}
+
+	if len(report.Errors) > 0 {
+		report.ErrorReport = s.uploadErrorReport(ctx, key, report.Errors)
+		return report, nil
+	}
+	if payload.DryRun || len(rows) == 0 {
+		return report, nil
+	}
+
+	for i := range rows {
+		if rows[i].restaurant.ID, err = utils.NewUUID(); err != nil {
+			return nil, utils.InternalServerError("매장 ID 생성 실패", err)
+		}
+		rows[i].business.RestaurantID = rows[i].restaurant.ID
+	}
+
+	reason := "CSV 가져오기: " + key
+	err = s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		for i := range rows {
+			row := &rows[i]
+			if err := s.restaurantRepo.CreateRestaurant(ctx, tx, &row.restaurant); err != nil {
+				return utils.InternalServerError(fmt.Sprintf("%d행 매장 등록 실패", row.row), err)
+			}
+			if err := s.restaurantRepo.CreateRestaurantBusiness(ctx, tx, &row.business); err != nil {
+				return utils.InternalServerError(fmt.Sprintf("%d행 사업자 정보 등록 실패", row.row), err)
+			}
+
+			schedule := &models.BusinessSchedule{RestaurantID: row.restaurant.ID, Hours: row.hours}
+			if err := s.restaurantRepo.ReplaceBusinessSchedule(ctx, tx, schedule); err != nil {
+				return utils.InternalServerError(fmt.Sprintf("%d행 영업 시간 등록 실패", row.row), err)
+			}
+
+			after := row.restaurant
+			after.Business = &row.business
+			after.BusinessHours = schedule.Hours
+			err := writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_RESTAURANT_IMPORT, models.AUDIT_TARGET_RESTAURANT, row.restaurant.ID, nil, &after, &reason)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 일괄 등록 실패")
+	}
+
+	report.Applied = true
+	for i, row := range rows {
+		report.Restaurants[i].RestaurantID = row.restaurant.ID
+	}
+	return report, nil
+}
+
+// importRecord는 CSV 한 행의 열 키별 값입니다.
+type importRecord struct {
+	row    int
+	values map[string]string
+}
+
+// readImportCSV는 머리글을 열 키로 바꾸고 빈 행을 제외한 데이터 행을 읽습니다.
+// 머리글이나 CSV 형식 자체가 잘못되었거나 행이 너무 많으면 파일 단위로 400을 반환합니다.
+func (s *ImportService) readImportCSV(body []byte) ([]importRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(body, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, utils.BadRequest("CSV 머리글을 읽을 수 없습니다", err)
+	}
+
+	byHeader := map[string]string{}
+	for _, column := range models.RestaurantImportColumns {
+		byHeader[strings.ToLower(column.Key)] = column.Key
+		byHeader[column.Header] = column.Key
+	}
+
+	keys := make([]string, len(header))
+	seen := map[string]bool{}
+	for i, name := range header {
+		key, ok := byHeader[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, utils.BadRequest(fmt.Sprintf("알 수 없는 열입니다: %s", name))
+		}
+		if seen[key] {
+			return nil, utils.BadRequest(fmt.Sprintf("열이 중복되었습니다: %s", name))
+		}
+		seen[key] = true
+		keys[i] = key
+	}
+	for _, column := range models.RestaurantImportColumns {
+		if column.Required && !seen[column.Key] {
+			return nil, utils.BadRequest(fmt.Sprintf("필수 열이 없습니다: %s (%s)", column.Key, column.Header))
+		}
+	}
+
+	records := []importRecord{}
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, utils.BadRequest(fmt.Sprintf("CSV 형식 오류: %v", err), err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		values := map[string]string{}
+		blank := true
+		for i, value := range fields {
+			if i >= len(keys) {
+				break
+			}
+			values[keys[i]] = strings.TrimSpace(value)
+			if values[keys[i]] != "" {
+				blank = false
+			}
+		}
+		if blank {
+			continue
+		}
+
+		records = append(records, importRecord{row: line, values: values})
+		if len(records) > s.importCfg.MaxRows {
+			return nil, utils.BadRequest(fmt.Sprintf("한 번에 %d행까지 가져올 수 있습니다", s.importCfg.MaxRows))
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, utils.BadRequest("가져올 행이 없습니다")
+	}
+	return records, nil
+}
+
+// parseRestaurantImportRow는 CSV 한 행을 매장, 사업자 정보, 영업 시간으로 변환하고 열별 오류를 모읍니다.
+func parseRestaurantImportRow(rowNumber int, values map[string]string) (restaurantImportRow, []models.ImportRowError) {
+	var rowErrors []models.ImportRowError
+	fail := func(column, message string) {
+		rowErrors = append(rowErrors, models.ImportRowError{Row: rowNumber, Column: column, Value: values[column], Message: message})
+	}
+
+	for _, column := range models.RestaurantImportColumns {
+		if column.Required && values[column.Key] == "" {
+			fail(column.Key, column.Header+"은(는) 필수입니다")
+		}
+	}
+
+	row := restaurantImportRow{
+		row: rowNumber,
+		restaurant: models.Restaurant{
+			Name:               values["name"],
+			OwnerID:            values["ownerId"],
+			Address:            values["address"],
+			PhoneNumber:        values["phoneNumber"],
+			Description:        optionalImportValue(values["description"]),
+			AddressDescription: optionalImportValue(values["addressDescription"]),
+			Holiday:            optionalImportValue(values["holiday"]),
+			ParkingDescription: optionalImportValue(values["parkingDescription"]),
+			Status:             models.HIDDEN,
+		},
+		business: models.RestaurantBusiness{
+			Name:            values["businessName"],
+			LicenseImageUrl: values["licenseImageUrl"],
+			LicenseNumber:   utils.NormalizeBusinessNumber(values["licenseNumber"]),
+		},
+		hours: []models.BusinessHour{},
+	}
+	if row.business.Name == "" {
+		row.business.Name = row.restaurant.Name
+	}
+
+	if utf8.RuneCountInString(row.restaurant.Name) > importNameMaxLength {
+		fail("name", fmt.Sprintf("매장명은 %d자 이하여야 합니다", importNameMaxLength))
+	}
+	if utf8.RuneCountInString(row.business.Name) > importNameMaxLength {
+		fail("businessName", fmt.Sprintf("상호는 %d자 이하여야 합니다", importNameMaxLength))
+	}
+	if utf8.RuneCountInString(row.restaurant.Address) > importAddressMaxLength {
+		fail("address", fmt.Sprintf("주소는 %d자 이하여야 합니다", importAddressMaxLength))
+	}
+	for _, key := range []string{"description", "addressDescription", "holiday", "parkingDescription"} {
+		if utf8.RuneCountInString(values[key]) > importTextMaxLength {
+			fail(key, fmt.Sprintf("%d자 이하여야 합니다", importTextMaxLength))
+		}
+	}
+
+	if row.restaurant.PhoneNumber != "" {
+		if utils.IsValidPhoneNumber(row.restaurant.PhoneNumber) {
+			row.restaurant.PhoneNumber = utils.NormalizePhoneNumber(row.restaurant.PhoneNumber)
+		} else {
+			fail("phoneNumber", "올바른 전화번호가 아닙니다")
+		}
+	}
+	if values["licenseNumber"] != "" && !utils.IsValidBusinessNumber(values["licenseNumber"]) {
+		fail("licenseNumber", "올바른 사업자등록번호가 아닙니다")
+	}
+	if imageURL := row.business.LicenseImageUrl; imageURL != "" {
+		if parsed, err := url.Parse(imageURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			fail("licenseImageUrl", "http(s) URL이어야 합니다")
+		}
+	}
+
+	if values["hours"] != "" {
+		hours, err := models.ParseBusinessHours(values["hours"])
+		if err == nil {
+			err = (&models.BusinessSchedule{Hours: hours}).Validate()
+		}
+		if err != nil {
+			fail("hours", err.Error())
+		} else {
+			row.hours = hours
+		}
+	}
+
+	// 가져온 매장은 사업자등록번호 확인과 상태 머신을 거치지 않으므로 항상 HIDDEN으로 만들고,
+	// 공개는 매장 상태 변경 API(RestaurantMachine)로만 할 수 있습니다
+	if status := strings.ToUpper(values["status"]); status != "" && models.RestaurantStatus(status) != models.HIDDEN {
+		fail("status", "가져온 매장은 HIDDEN으로만 등록할 수 있습니다. 공개는 매장 상태 변경으로 해주세요")
+	}
+
+	var ok bool
+	if row.restaurant.ParkingAvailable, ok = parseImportBool(values["parkingAvailable"]); !ok {
+		fail("parkingAvailable", "Y/N 또는 true/false여야 합니다")
+	}
+	if row.restaurant.DeliveryAvailable, ok = parseImportBool(values["deliveryAvailable"]); !ok {
+		fail("deliveryAvailable", "Y/N 또는 true/false여야 합니다")
+	}
+
+	return row, rowErrors
+}
+
+// checkImportReferences는 점주 계정과 사업자등록번호 중복을 확인해 오류가 난 행을 제외합니다.
+// 사업자등록번호는 파일 안에서 중복되거나 삭제되지 않은 다른 매장이 이미 쓰고 있으면 오류입니다.
+func (s *ImportService) checkImportReferences(ctx context.Context, rows []restaurantImportRow, report *models.RestaurantImportReport) ([]restaurantImportRow, error) {
+	ownerIDs := make([]string, 0, len(rows))
+	licenseNumbers := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ownerIDs = append(ownerIDs, row.restaurant.OwnerID)
+		licenseNumbers = append(licenseNumbers, row.business.LicenseNumber)
+	}
+
+	owners, err := s.userRepo.GetUsersByIDs(ctx, ownerIDs)
+	if err != nil {
+		return nil, utils.InternalServerError("점주 계정 조회 실패", err)
+	}
+	inUse, err := s.restaurantRepo.FindRestaurantIDsByLicenseNumbers(ctx, licenseNumbers)
+	if err != nil {
+		return nil, utils.InternalServerError("사업자등록번호 중복 조회 실패", err)
+	}
+
+	valid := rows[:0]
+	firstRow := map[string]int{}
+	for _, row := range rows {
+		var rowErrors []models.ImportRowError
+		fail := func(column, value, message string) {
+			rowErrors = append(rowErrors, models.ImportRowError{Row: row.row, Column: column, Value: value, Message: message})
+		}
+
+		owner, ok := owners[row.restaurant.OwnerID]
+		switch {
+		case !ok:
+			fail("ownerId", row.restaurant.OwnerID, "점주 계정을 찾을 수 없습니다")
+		case owner.IsSuspended():
+			fail("ownerId", row.restaurant.OwnerID, "정지된 계정입니다")
+		case owner.Role != models.OWNER:
+			fail("ownerId", row.restaurant.OwnerID, "점주(OWNER) 계정이 아닙니다")
+		}
+
+		licenseNumber := row.business.LicenseNumber
+		if restaurantID, ok := inUse[licenseNumber]; ok {
+			fail("licenseNumber", licenseNumber, fmt.Sprintf("이미 다른 매장(%s)에서 사용 중인 사업자등록번호입니다", restaurantID))
+		} else if first, ok := firstRow[licenseNumber]; ok {
+			fail("licenseNumber", licenseNumber, fmt.Sprintf("%d행과 사업자등록번호가 중복됩니다", first))
+		} else {
+			firstRow[licenseNumber] = row.row
+		}
+
+		report.Errors = append(report.Errors, rowErrors...)
+		if len(rowErrors) == 0 {
+			valid = append(valid, row)
+		}
+	}
+
+	// 같은 행의 오류는 발견한 순서를 유지하며 행 번호 순으로 정렬
+	sort.SliceStable(report.Errors, func(i, j int) bool { return report.Errors[i].Row < report.Errors[j].Row })
+	return valid, nil
+}
+
+// uploadErrorReport는 행 오류를 CSV로 저장하고 다운로드 정보를 반환합니다.
+// 오류 목록은 응답에도 포함되므로 저장에 실패하면 로그만 남깁니다.
+func (s *ImportService) uploadErrorReport(ctx context.Context, key string, rowErrors []models.ImportRowError) *models.ExportDownloadResponse {
+	rows := make([][]string, 0, len(rowErrors))
+	for _, rowError := range rowErrors {
+		rows = append(rows, []string{fmt.Sprint(rowError.Row), rowError.Column, rowError.Value, rowError.Message})
+	}
+
+	body, err := utils.WriteCSV([]string{"행", "열", "값", "오류"}, rows)
+	if err != nil {
+		log.Printf("가져오기 오류 리포트 생성 실패 (%s): %v", key, err)
+		return nil
+	}
+
+	file := &models.ExportFile{
+		FileName:    strings.TrimSuffix(path.Base(key), ".csv") + "_errors.csv",
+		ContentType: models.EXPORT_CSV.ContentType(),
+		Rows:        len(rows),
+		Body:        body,
+	}
+
+	download, err := storeDownload(ctx, s.s3Service, s.importCfg.Bucket, strings.TrimSuffix(key, ".csv")+".errors.csv", file, models.EXPORT_CSV, s.importCfg.URLExpiry)
+	if err != nil {
+		log.Printf("가져오기 오류 리포트 저장 실패 (%s): %v", key, err)
+		return nil
+	}
+	return download
+}
+
+func importedRestaurant(row restaurantImportRow) models.ImportedRestaurant {
+	return models.ImportedRestaurant{
+		Row:           row.row,
+		Name:          row.restaurant.Name,
+		OwnerID:       row.restaurant.OwnerID,
+		LicenseNumber: row.business.LicenseNumber,
+		Status:        row.restaurant.Status,
+		BusinessHours: len(row.hours),
+	}
+}
+
+func optionalImportValue(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// parseImportBool은 Y/N, true/false, 1/0 값을 변환합니다. 비어 있으면 false입니다.
+func parseImportBool(value string) (bool, bool) {
+	switch strings.ToUpper(value) {
+	case "", "N", "FALSE", "0":
+		return false, true
+	case "Y", "TRUE", "1":
+		return true, true
+	}
+	return false, false
+}
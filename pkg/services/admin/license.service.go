@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"lambda-go/pkg/models"
+	repository "lambda-go/pkg/repositories"
+	"lambda-go/pkg/utils"
+)
+
+// VerifyRestaurantRequestLicense는 저장된 결과와 관계없이 매장 요청의 사업자등록번호 상태를 다시 조회합니다.
+func (s *RestaurantService) VerifyRestaurantRequestLicense(ctx context.Context, requestID string) (*models.RestaurantRequest, error) {
+	request, err := s.restaurantRepo.GetRestaurantRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, repositoryError(err, "매장 요청 조회 실패")
+	}
+	if request.BusinessLicenseNumber == nil {
+		return nil, utils.BadRequest("사업자등록번호가 없는 요청입니다")
+	}
+
+	results, err := s.licenseChecker.Check(ctx, []string{*request.BusinessLicenseNumber}, true)
+	if err != nil {
+		return nil, utils.InternalServerError("사업자등록번호 상태 조회 실패", err)
+	}
+
+	request.LicenseVerification = results[utils.NormalizeBusinessNumber(*request.BusinessLicenseNumber)]
+	return request, nil
+}
+
+// attachLicenseVerification은 매장 요청 상세에 사업자등록번호 조회 결과를 채웁니다.
+// 저장된 결과가 없거나 오래되었으면 새로 조회하며, 조회에 실패해도 상세 조회는 실패시키지 않습니다.
+func (s *RestaurantService) attachLicenseVerification(ctx context.Context, request *models.RestaurantRequest) {
+	if request.BusinessLicenseNumber == nil {
+		return
+	}
+
+	results, err := s.licenseChecker.Check(ctx, []string{*request.BusinessLicenseNumber}, false)
+	if err != nil {
+		log.Printf("사업자등록번호 조회 결과 확인 실패 (요청 ID %d): %v", request.ID, err)
+		return
+	}
+	request.LicenseVerification = results[utils.NormalizeBusinessNumber(*request.BusinessLicenseNumber)]
+}
+
+// prepareLicenseChecks는 승인 전에 요청들의 사업자등록번호 상태를 미리 조회해 둡니다.
+// 외부 조회는 트랜잭션 밖에서 수행하고, 승인 가드는 트랜잭션 안에서 저장된 결과만 확인합니다.
+// 사업자등록번호는 한 번의 쿼리로 조회하며, 조회할 수 없는 요청은 건너뛰어 이후 처리에서 해당 요청의 에러로 보고됩니다.
+func (s *RestaurantService) prepareLicenseChecks(ctx context.Context, requestIDs []string) error {
+	ids := make([]int64, 0, len(requestIDs))
+	for _, id := range requestIDs {
+		if n, err := strconv.ParseInt(id, 10, 32); err == nil {
+			ids = append(ids, n)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	numbers, err := s.restaurantRepo.GetPendingLicenseNumbers(ctx, ids)
+	if err != nil {
+		return utils.InternalServerError("사업자등록번호 조회 실패", err)
+	}
+	if len(numbers) == 0 {
+		return nil
+	}
+
+	if _, err := s.licenseChecker.Check(ctx, numbers, false); err != nil {
+		return utils.InternalServerError("사업자등록번호 상태 조회 실패", err)
+	}
+	return nil
+}
+
+// currentLicense는 트랜잭션 안에서 매장 요청의 저장된 사업자등록번호 조회 결과를 반환합니다.
+func (s *RestaurantService) currentLicense(ctx context.Context, q repository.Querier, request *models.RestaurantRequest) (*models.BusinessLicenseVerification, error) {
+	if request.BusinessLicenseNumber == nil {
+		return nil, nil
+	}
+
+	license, err := s.licenseChecker.Current(ctx, q, *request.BusinessLicenseNumber)
+	if err != nil {
+		return nil, utils.InternalServerError("사업자등록번호 조회 결과 확인 실패", err)
+	}
+	return license, nil
+}
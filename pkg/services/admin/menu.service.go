@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// GetRestaurantMenus는 매장의 메뉴 목록을 조회합니다. hidden이 주어지면 숨김 여부로 필터링합니다.
+func (s *RestaurantService) GetRestaurantMenus(ctx context.Context, restaurantID string, hidden *bool) ([]models.RestaurantMenu, error) {
+	if _, err := s.restaurantRepo.GetRestaurantByID(ctx, restaurantID); err != nil {
+		return nil, repositoryError(err, "매장 조회 실패")
+	}
+
+	menus, err := s.restaurantRepo.GetRestaurantMenus(ctx, restaurantID, hidden)
+	if err != nil {
+		return nil, utils.InternalServerError("메뉴 목록 조회 실패", err)
+	}
+	return menus, nil
+}
+
+// ModerateRestaurantMenu는 메뉴 정보를 수정하거나 메뉴/이미지를 숨기고, 검수 사유를 감사 로그에 남깁니다.
+func (s *RestaurantService) ModerateRestaurantMenu(ctx context.Context, actor models.AuditActor, restaurantID, menuID string, payload *models.ModerateRestaurantMenuRequest) (*models.RestaurantMenu, error) {
+	if payload.Name == nil && payload.Price == nil && payload.Description == nil && payload.Hidden == nil && payload.ImageHidden == nil {
+		return nil, utils.BadRequest("변경할 항목이 없습니다")
+	}
+
+	var menu *models.RestaurantMenu
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		menu, err = s.restaurantRepo.LockRestaurantMenu(ctx, tx, restaurantID, menuID)
+		if err != nil {
+			return repositoryError(err, "메뉴 조회 실패")
+		}
+
+		before := *menu
+		applyMenuModeration(menu, payload)
+
+		now := time.Now()
+		menu.ModerationReason = &payload.Reason
+		menu.ModeratedBy = &actor.UserID
+		menu.ModeratedAt = &now
+
+		if err := s.restaurantRepo.UpdateRestaurantMenu(ctx, tx, menu); err != nil {
+			return repositoryError(err, "메뉴 수정 실패")
+		}
+
+		return s.writeAudit(ctx, tx, actor, models.AUDIT_MENU_UPDATE, models.AUDIT_TARGET_RESTAURANT_MENU, menuID, &before, menu, &payload.Reason)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "메뉴 수정 실패")
+	}
+
+	return menu, nil
+}
+
+// HideRestaurantMenus는 매장의 모든 메뉴를 숨기고, 새로 숨긴 메뉴마다 감사 로그를 남깁니다.
+func (s *RestaurantService) HideRestaurantMenus(ctx context.Context, actor models.AuditActor, restaurantID string, reason string) (*models.HideRestaurantMenusResponse, error) {
+	result := &models.HideRestaurantMenusResponse{RestaurantID: restaurantID}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		if _, err := s.restaurantRepo.LockRestaurant(ctx, tx, restaurantID); err != nil {
+			return repositoryError(err, "매장 조회 실패")
+		}
+
+		menus, err := s.restaurantRepo.LockVisibleRestaurantMenus(ctx, tx, restaurantID)
+		if err != nil {
+			return utils.InternalServerError("메뉴 목록 조회 실패", err)
+		}
+
+		now := time.Now()
+		count, err := s.restaurantRepo.HideRestaurantMenus(ctx, tx, restaurantID, reason, actor.UserID, now)
+		if err != nil {
+			return utils.InternalServerError("메뉴 일괄 숨김 실패", err)
+		}
+		result.HiddenCount = int(count)
+
+		for i := range menus {
+			before := menus[i]
+			after := menus[i]
+			after.Hidden = true
+			after.ModerationReason = &reason
+			after.ModeratedBy = &actor.UserID
+			after.ModeratedAt = &now
+
+			err := s.writeAudit(ctx, tx, actor, models.AUDIT_MENU_HIDE_ALL, models.AUDIT_TARGET_RESTAURANT_MENU, before.ID, &before, &after, &reason)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, repositoryError(err, "메뉴 일괄 숨김 실패")
+	}
+
+	return result, nil
+}
+
+// applyMenuModeration은 검수 페이로드에 포함된 필드만 메뉴에 반영합니다.
+// 설명에 빈 문자열이 오면 값을 비웁니다.
+func applyMenuModeration(menu *models.RestaurantMenu, payload *models.ModerateRestaurantMenuRequest) {
+	if payload.Name != nil {
+		menu.Name = *payload.Name
+	}
+	if payload.Price != nil {
+		menu.Price = *payload.Price
+	}
+	if payload.Description != nil {
+		if *payload.Description == "" {
+			menu.Description = nil
+		} else {
+			description := *payload.Description
+			menu.Description = &description
+		}
+	}
+	if payload.Hidden != nil {
+		menu.Hidden = *payload.Hidden
+	}
+	if payload.ImageHidden != nil {
+		menu.ImageHidden = *payload.ImageHidden
+	}
+}
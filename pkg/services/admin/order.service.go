@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	repository "lambda-go/pkg/repositories"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// OrderService는 관리자 주문 조회와 CS 강제 취소 서비스를 제공합니다.
+type OrderService struct {
+	config         *config.Config
+	txManager      *repository.TxManager
+	orderRepo      *repository.OrderRepository
+	restaurantRepo *repository.RestaurantRepository
+	eventRepo      *repository.EventRepository
+	auditRepo      *repository.AuditRepository
+}
+
+// NewOrderService는 새 OrderService 인스턴스를 생성합니다.
+func NewOrderService(cfg *config.Config, txManager *repository.TxManager, orderRepo *repository.OrderRepository, restaurantRepo *repository.RestaurantRepository, eventRepo *repository.EventRepository, auditRepo *repository.AuditRepository) *OrderService {
+	return &OrderService{
+		config:         cfg,
+		txManager:      txManager,
+		orderRepo:      orderRepo,
+		restaurantRepo: restaurantRepo,
+		eventRepo:      eventRepo,
+		auditRepo:      auditRepo,
+	}
+}
+
+// GetOrders는 주문 목록을 검색합니다.
+func (s *OrderService) GetOrders(ctx context.Context, query dto.OrderQuery) (*models.OrdersResponse, error) {
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, utils.BadRequest("from은 to보다 이전이어야 합니다")
+	}
+
+	orders, total, err := s.orderRepo.GetOrders(ctx, query)
+	if err != nil {
+		return nil, utils.InternalServerError("주문 목록 조회 실패", err)
+	}
+
+	return &models.OrdersResponse{
+		Orders: orders,
+		Pagination: models.Pagination{
+			Total:      total,
+			Page:       query.Page,
+			PageSize:   query.PageSize,
+			TotalPages: (total + query.PageSize - 1) / query.PageSize,
+		},
+	}, nil
+}
+
+// GetOrder는 주문 상세를 매장, 고객, 주문 메뉴, 배달 정보와 함께 조회합니다.
+func (s *OrderService) GetOrder(ctx context.Context, orderID string) (*models.Order, error) {
+	order, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, repositoryError(err, "주문 조회 실패")
+	}
+
+	order.Menus, err = s.orderRepo.GetOrderMenus(ctx, orderID)
+	if err != nil {
+		return nil, utils.InternalServerError("주문 메뉴 조회 실패", err)
+	}
+
+	order.Delivery, err = s.orderRepo.GetOrderDelivery(ctx, orderID)
+	if err != nil {
+		return nil, utils.InternalServerError("주문 배달 정보 조회 실패", err)
+	}
+
+	order.Customer, err = s.orderRepo.GetCustomer(ctx, order.CustomerID)
+	if err != nil {
+		return nil, utils.InternalServerError("고객 조회 실패", err)
+	}
+
+	// 매장이 없어도 주문 조회는 가능해야 하므로 매장 정보만 비움
+	order.Restaurant, err = s.restaurantRepo.GetRestaurantByID(ctx, order.RestaurantID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, utils.InternalServerError("매장 조회 실패", err)
+	}
+
+	return order, nil
+}
+
+// CancelOrder는 CS 처리를 위해 주문을 강제 취소합니다.
+// 접수 대기/처리 중인 주문만 취소할 수 있으며, 취소 사유를 주문과 감사 로그에 남기고 OrderCancelled 이벤트를 발행합니다.
+func (s *OrderService) CancelOrder(ctx context.Context, actor models.AuditActor, orderID string, reason string) (*models.Order, error) {
+	var order *models.Order
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		order, err = s.orderRepo.LockOrder(ctx, tx, orderID)
+		if err != nil {
+			return repositoryError(err, "주문 조회 실패")
+		}
+
+		next, err := models.OrderMachine.Next(order.Status, models.ORDER_CANCEL, order)
+		if err != nil {
+			return transitionError(err)
+		}
+
+		cancelledAt, err := s.orderRepo.CancelOrder(ctx, tx, orderID, order.Status, reason, actor.UserID)
+		if err != nil {
+			return repositoryError(err, "주문 취소 실패")
+		}
+
+		before := *order
+		order.Status = next
+		order.CancelReason = &reason
+		order.CancelledBy = &actor.UserID
+		order.CancelledAt = &cancelledAt
+		order.UpdatedAt = cancelledAt
+
+		err = writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_ORDER_CANCEL, models.AUDIT_TARGET_ORDER, orderID, &before, order, &reason)
+		if err != nil {
+			return err
+		}
+
+		return recordDomainEvent(ctx, s.eventRepo, tx, actor, models.EVENT_ORDER_CANCELLED, models.AUDIT_TARGET_ORDER, orderID, models.OrderCancelledData{
+			OrderID:      orderID,
+			RestaurantID: order.RestaurantID,
+			CustomerID:   order.CustomerID,
+			From:         before.Status,
+			Reason:       reason,
+			CancelledBy:  actor.UserID,
+			CancelledAt:  cancelledAt,
+		})
+	})
+	if err != nil {
+		return nil, repositoryError(err, "주문 취소 실패")
+	}
+
+	return order, nil
+}
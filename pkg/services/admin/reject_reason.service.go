@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	repository "lambda-go/pkg/repositories"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// rejectReasonCodePattern은 거절 사유 코드 형식입니다 (대문자, 숫자, 밑줄).
+var rejectReasonCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// RejectReasonService는 거절 사유 코드 카탈로그 관리 서비스를 제공합니다.
+type RejectReasonService struct {
+	config           *config.Config
+	txManager        *repository.TxManager
+	rejectReasonRepo *repository.RejectReasonRepository
+	auditRepo        *repository.AuditRepository
+}
+
+// NewRejectReasonService는 새 RejectReasonService 인스턴스를 생성합니다.
+func NewRejectReasonService(cfg *config.Config, txManager *repository.TxManager, rejectReasonRepo *repository.RejectReasonRepository, auditRepo *repository.AuditRepository) *RejectReasonService {
+	return &RejectReasonService{
+		config:           cfg,
+		txManager:        txManager,
+		rejectReasonRepo: rejectReasonRepo,
+		auditRepo:        auditRepo,
+	}
+}
+
+// GetRejectReasonCodes는 거절 사유 코드 목록을 조회합니다.
+func (s *RejectReasonService) GetRejectReasonCodes(ctx context.Context, includeInactive bool) ([]models.RejectReasonCode, error) {
+	codes, err := s.rejectReasonRepo.List(ctx, !includeInactive)
+	if err != nil {
+		return nil, utils.InternalServerError("거절 사유 코드 목록 조회 실패", err)
+	}
+	return codes, nil
+}
+
+// CreateRejectReasonCode는 거절 사유 코드를 추가합니다.
+func (s *RejectReasonService) CreateRejectReasonCode(ctx context.Context, actor models.AuditActor, payload *models.CreateRejectReasonCodeRequest) (*models.RejectReasonCode, error) {
+	code := strings.ToUpper(strings.TrimSpace(payload.Code))
+	if !rejectReasonCodePattern.MatchString(code) {
+		return nil, utils.BadRequest("거절 사유 코드는 영문 대문자, 숫자, 밑줄(_)만 사용할 수 있습니다")
+	}
+
+	item := &models.RejectReasonCode{
+		Code:       code,
+		TitleKo:    payload.TitleKo,
+		TitleEn:    payload.TitleEn,
+		TemplateKo: payload.TemplateKo,
+		TemplateEn: payload.TemplateEn,
+		Active:     true,
+		SortOrder:  payload.SortOrder,
+	}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		if err := s.rejectReasonRepo.Create(ctx, tx, item); err != nil {
+			return rejectReasonError(err, "거절 사유 코드 생성 실패")
+		}
+		return writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_REJECT_REASON_CREATE, models.AUDIT_TARGET_REJECT_REASON, code, nil, item, nil)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "거절 사유 코드 생성 실패")
+	}
+
+	return item, nil
+}
+
+// UpdateRejectReasonCode는 거절 사유 코드의 문구, 정렬 순서, 활성 여부를 변경합니다.
+func (s *RejectReasonService) UpdateRejectReasonCode(ctx context.Context, actor models.AuditActor, code string, payload *models.UpdateRejectReasonCodeRequest) (*models.RejectReasonCode, error) {
+	return s.updateRejectReasonCode(ctx, actor, code, models.AUDIT_REJECT_REASON_UPDATE, func(item *models.RejectReasonCode) {
+		if payload.TitleKo != nil {
+			item.TitleKo = *payload.TitleKo
+		}
+		if payload.TitleEn != nil {
+			item.TitleEn = *payload.TitleEn
+		}
+		if payload.TemplateKo != nil {
+			item.TemplateKo = *payload.TemplateKo
+		}
+		if payload.TemplateEn != nil {
+			item.TemplateEn = *payload.TemplateEn
+		}
+		if payload.Active != nil {
+			item.Active = *payload.Active
+		}
+		if payload.SortOrder != nil {
+			item.SortOrder = *payload.SortOrder
+		}
+	})
+}
+
+// DeactivateRejectReasonCode는 거절 사유 코드를 비활성화합니다.
+// 기존 요청이 코드를 참조하고 있으므로 행을 삭제하지 않습니다.
+func (s *RejectReasonService) DeactivateRejectReasonCode(ctx context.Context, actor models.AuditActor, code string) (*models.RejectReasonCode, error) {
+	return s.updateRejectReasonCode(ctx, actor, code, models.AUDIT_REJECT_REASON_DEACTIVATE, func(item *models.RejectReasonCode) {
+		item.Active = false
+	})
+}
+
+// updateRejectReasonCode는 코드 행을 잠근 뒤 변경 함수를 적용하고 감사 로그를 남깁니다.
+func (s *RejectReasonService) updateRejectReasonCode(ctx context.Context, actor models.AuditActor, code string, action models.AuditAction, apply func(item *models.RejectReasonCode)) (*models.RejectReasonCode, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	var result *models.RejectReasonCode
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		current, err := s.rejectReasonRepo.LockByCode(ctx, tx, code)
+		if err != nil {
+			return repositoryError(err, "거절 사유 코드 조회 실패")
+		}
+
+		before := *current
+		apply(current)
+
+		if err := s.rejectReasonRepo.Update(ctx, tx, current); err != nil {
+			return repositoryError(err, "거절 사유 코드 수정 실패")
+		}
+
+		result = current
+		return writeAuditLog(ctx, s.auditRepo, tx, actor, action, models.AUDIT_TARGET_REJECT_REASON, code, &before, current, nil)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "거절 사유 코드 수정 실패")
+	}
+
+	return result, nil
+}
+
+// rejectReasonError는 코드 중복을 알아보기 쉬운 메시지로 변환합니다.
+func rejectReasonError(err error, message string) error {
+	if errors.Is(err, repository.ErrConflict) {
+		return utils.Conflict("이미 존재하는 거절 사유 코드입니다", err)
+	}
+	return repositoryError(err, message)
+}
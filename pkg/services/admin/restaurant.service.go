@@ -2,26 +2,50 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 
 	config "lambda-go/pkg/configs"
 	"lambda-go/pkg/models"
 	dto "lambda-go/pkg/models/dtos"
 	repository "lambda-go/pkg/repositories"
+	licenseService "lambda-go/pkg/services/license"
 	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
 )
 
+// bulkProcessChunkSize는 일괄 처리 시 한 트랜잭션에서 처리할 요청 수입니다.
+const bulkProcessChunkSize = 50
+
 // RestaurantService는 매장 관련 서비스를 제공합니다.
 type RestaurantService struct {
-	config         *config.Config
-	restaurantRepo *repository.RestaurantRepository
+	config           *config.Config
+	txManager        *repository.TxManager
+	restaurantRepo   *repository.RestaurantRepository
+	rejectReasonRepo *repository.RejectReasonRepository
+	notificationRepo *repository.NotificationRepository
+	eventRepo        *repository.EventRepository
+	auditRepo        *repository.AuditRepository
+	licenseChecker   *licenseService.Checker
 }
 
 // NewRestaurantService는 새 RestaurantService 인스턴스를 생성합니다.
-func NewRestaurantService(cfg *config.Config, restaurantRepo *repository.RestaurantRepository) *RestaurantService {
+func NewRestaurantService(cfg *config.Config, txManager *repository.TxManager, restaurantRepo *repository.RestaurantRepository, rejectReasonRepo *repository.RejectReasonRepository, notificationRepo *repository.NotificationRepository, eventRepo *repository.EventRepository, auditRepo *repository.AuditRepository, licenseChecker *licenseService.Checker) *RestaurantService {
 	return &RestaurantService{
-		config:         cfg,
-		restaurantRepo: restaurantRepo,
+		config:           cfg,
+		txManager:        txManager,
+		restaurantRepo:   restaurantRepo,
+		rejectReasonRepo: rejectReasonRepo,
+		notificationRepo: notificationRepo,
+		eventRepo:        eventRepo,
+		auditRepo:        auditRepo,
+		licenseChecker:   licenseChecker,
 	}
 }
 
@@ -43,24 +67,527 @@ func (s *RestaurantService) GetRestaurantRequests(ctx context.Context, query dto
 	}, nil
 }
 
+// GetRestaurantRequest는 매장 요청 상세를 사업자등록번호 조회 결과, 중복 의심 경고와 함께 조회합니다.
+func (s *RestaurantService) GetRestaurantRequest(ctx context.Context, requestID string) (*models.RestaurantRequest, error) {
+	request, err := s.restaurantRepo.GetRestaurantRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, repositoryError(err, "매장 요청 조회 실패")
+	}
+
+	s.attachLicenseVerification(ctx, request)
+
+	request.DuplicateWarnings, err = s.restaurantRepo.FindRestaurantRequestDuplicates(ctx, requestID)
+	if err != nil {
+		return nil, utils.InternalServerError("중복 후보 조회 실패", err)
+	}
+	return request, nil
+}
+
 // ProcessRestaurantRequest는 매장 생성 요청을 승인하거나 거절합니다.
-func (s *RestaurantService) ProcessRestaurantRequest(ctx context.Context, requestID string, payload *models.ProcessRestaurantRequest) (*models.RestaurantRequest, error) {
-	// 현재 상태 조회
-	currentStatus, err := s.restaurantRepo.GetRestaurantRequestByID(ctx, requestID)
+// expectedVersion이 주어지면 현재 버전과 일치할 때만 처리합니다 (If-Match).
+func (s *RestaurantService) ProcessRestaurantRequest(ctx context.Context, actor models.AuditActor, requestID string, payload *models.ProcessRestaurantRequest, expectedVersion *int) (*models.RestaurantRequest, error) {
+	action := models.REQUEST_APPROVE
+	if payload.Status == models.REJECTED {
+		action = models.REQUEST_REJECT
+	}
+
+	opts, err := s.processOptions(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	opts.ExpectedVersion = expectedVersion
+
+	if action == models.REQUEST_APPROVE && !opts.LicenseOverride {
+		if err := s.prepareLicenseChecks(ctx, []string{requestID}); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.transitionRestaurantRequest(ctx, actor, requestID, action, opts)
+}
+
+// BulkProcessRestaurantRequests는 여러 매장 요청을 같은 결정으로 일괄 처리합니다.
+// 요청은 청크 단위 트랜잭션으로 처리되며, 각 요청은 세이브포인트 안에서 처리되어 하나가 실패해도 나머지는 반영됩니다.
+func (s *RestaurantService) BulkProcessRestaurantRequests(ctx context.Context, actor models.AuditActor, payload *models.BulkProcessRestaurantRequest) (*models.BulkProcessRestaurantResponse, error) {
+	action := models.REQUEST_APPROVE
+	if payload.Status == models.REJECTED {
+		action = models.REQUEST_REJECT
+	}
+
+	// 거절 사유는 모든 요청에 동일하므로 한 번만 검증
+	opts, err := s.processOptions(ctx, &payload.ProcessRestaurantRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &models.BulkProcessRestaurantResponse{
+		Results: make([]models.BulkProcessItemResult, 0, len(payload.IDs)),
+	}
+
+	// 요청 ID 컬럼은 정수이므로 정수가 아닌 ID는 조회하지 않고 항목별 오류로 응답
+	// 중복 제거 후 숫자 순으로 정렬하여 동시 일괄 처리 간 잠금 순서를 일정하게 유지
+	numericIDs := make([]int64, 0, len(payload.IDs))
+	seen := make(map[int64]bool, len(payload.IDs))
+	for _, id := range payload.IDs {
+		n, err := strconv.ParseInt(id, 10, 32)
+		if err != nil || n <= 0 {
+			resp.Results = append(resp.Results, models.BulkProcessItemResult{ID: id, Result: models.BULK_INVALID_ID, Message: "요청 ID는 양의 정수여야 합니다"})
+			continue
+		}
+		if !seen[n] {
+			seen[n] = true
+			numericIDs = append(numericIDs, n)
+		}
+	}
+	sort.Slice(numericIDs, func(i, j int) bool { return numericIDs[i] < numericIDs[j] })
+
+	ids := make([]string, len(numericIDs))
+	for i, n := range numericIDs {
+		ids[i] = strconv.FormatInt(n, 10)
+	}
+
+	if action == models.REQUEST_APPROVE && !opts.LicenseOverride && len(ids) > 0 {
+		if err := s.prepareLicenseChecks(ctx, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	for start := 0; start < len(ids); start += bulkProcessChunkSize {
+		end := start + bulkProcessChunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+		results := make([]models.BulkProcessItemResult, 0, len(chunk))
+
+		err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+			for _, id := range chunk {
+				var processed *models.RestaurantRequest
+				err := repository.RunInSavepoint(ctx, tx, func(sp pgx.Tx) error {
+					var err error
+					processed, err = s.transitionRestaurantRequestTx(ctx, sp, actor, id, action, opts)
+					return err
+				})
+				results = append(results, bulkItemResult(id, processed, err))
+			}
+			return nil
+		})
+
+		// 청크 커밋 실패 시 해당 청크의 성공 결과도 모두 실패로 처리
+		if err != nil {
+			for i := range results {
+				if results[i].Result == models.BULK_SUCCEEDED {
+					results[i] = models.BulkProcessItemResult{ID: results[i].ID, Result: models.BULK_ERROR, Message: "트랜잭션 커밋 실패"}
+				}
+			}
+		}
+
+		resp.Results = append(resp.Results, results...)
+	}
+
+	for _, result := range resp.Results {
+		if result.Result == models.BULK_SUCCEEDED {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	return resp, nil
+}
+
+// bulkItemResult는 단건 처리 결과를 일괄 처리 결과 항목으로 변환합니다.
+func bulkItemResult(id string, processed *models.RestaurantRequest, err error) models.BulkProcessItemResult {
+	if err == nil {
+		return models.BulkProcessItemResult{ID: id, Result: models.BULK_SUCCEEDED, Request: processed}
+	}
+
+	appErr, ok := repositoryError(err, "매장 요청 처리 실패").(*utils.AppError)
+	if !ok {
+		return models.BulkProcessItemResult{ID: id, Result: models.BULK_ERROR, Message: err.Error()}
+	}
+
+	result := models.BULK_ERROR
+	switch appErr.StatusCode {
+	case http.StatusNotFound:
+		result = models.BULK_NOT_FOUND
+	case http.StatusConflict:
+		result = models.BULK_ALREADY_PROCESSED
+	}
+	return models.BulkProcessItemResult{ID: id, Result: result, Message: appErr.Message}
+}
+
+// ReopenRestaurantRequest는 거절된 매장 요청을 다시 검토 대기 상태로 되돌립니다.
+func (s *RestaurantService) ReopenRestaurantRequest(ctx context.Context, actor models.AuditActor, requestID string, expectedVersion *int) (*models.RestaurantRequest, error) {
+	return s.transitionRestaurantRequest(ctx, actor, requestID, models.REQUEST_REOPEN, transitionOptions{ExpectedVersion: expectedVersion})
+}
+
+// WithdrawRestaurantRequest는 검토 대기 중인 매장 요청을 철회합니다.
+func (s *RestaurantService) WithdrawRestaurantRequest(ctx context.Context, actor models.AuditActor, requestID string, expectedVersion *int) (*models.RestaurantRequest, error) {
+	return s.transitionRestaurantRequest(ctx, actor, requestID, models.REQUEST_WITHDRAW, transitionOptions{ExpectedVersion: expectedVersion})
+}
+
+// ChangeRestaurantStatus는 매장 상태 머신에 따라 매장 상태를 변경하고 사유를 감사 로그에 남깁니다.
+func (s *RestaurantService) ChangeRestaurantStatus(ctx context.Context, actor models.AuditActor, restaurantID string, action models.RestaurantAction, reason string) (*models.Restaurant, error) {
+	var restaurant *models.Restaurant
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		restaurant, err = s.restaurantRepo.LockRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return repositoryError(err, "매장 조회 실패")
+		}
+
+		next, err := models.RestaurantMachine.Next(restaurant.Status, action, restaurant)
+		if err != nil {
+			return transitionError(err)
+		}
+
+		if err := s.restaurantRepo.UpdateRestaurantStatus(ctx, tx, restaurantID, next); err != nil {
+			return repositoryError(err, "매장 상태 변경 실패")
+		}
+
+		before := *restaurant
+		restaurant.Status = next
+		err = s.writeAudit(ctx, tx, actor, models.AUDIT_RESTAURANT_STATUS_CHANGE, models.AUDIT_TARGET_RESTAURANT, restaurantID, &before, restaurant, &reason)
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, actor, models.EVENT_RESTAURANT_STATUS_CHANGED, models.AUDIT_TARGET_RESTAURANT, restaurantID, models.RestaurantStatusChangedData{
+			RestaurantID: restaurantID,
+			From:         before.Status,
+			To:           next,
+			Action:       action,
+		})
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 상태 변경 실패")
+	}
+
+	return restaurant, nil
+}
+
+// transitionRestaurantRequest는 트랜잭션 안에서 상태 머신을 거쳐 매장 요청 상태와 연결된 매장 상태를 변경합니다.
+func (s *RestaurantService) transitionRestaurantRequest(ctx context.Context, actor models.AuditActor, requestID string, action models.RestaurantRequestAction, opts transitionOptions) (*models.RestaurantRequest, error) {
+	var result *models.RestaurantRequest
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		result, err = s.transitionRestaurantRequestTx(ctx, tx, actor, requestID, action, opts)
+		return err
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 요청 처리 실패")
+	}
+
+	return result, nil
+}
+
+// transitionRestaurantRequestTx는 주어진 트랜잭션 안에서 매장 요청 하나를 전이합니다.
+func (s *RestaurantService) transitionRestaurantRequestTx(ctx context.Context, tx pgx.Tx, actor models.AuditActor, requestID string, action models.RestaurantRequestAction, opts transitionOptions) (*models.RestaurantRequest, error) {
+	// 현재 상태를 행 잠금과 함께 조회하여 동시 처리를 직렬화
+	current, err := s.restaurantRepo.LockRestaurantRequest(ctx, tx, requestID)
+	if err != nil {
+		return nil, repositoryError(err, "매장 요청 조회 실패")
+	}
+
+	// If-Match 버전 확인
+	if opts.ExpectedVersion != nil && *opts.ExpectedVersion != current.Version {
+		return nil, utils.Conflict(fmt.Sprintf("요청이 다른 관리자에 의해 변경되었습니다 (현재 버전: %d)", current.Version))
+	}
+
+	// 다른 검토자의 점유 확인
+	if err := s.checkClaim(actor, current, opts.Override); err != nil {
+		return nil, err
+	}
+
+	// 승인 가드에 사용할 사업자등록번호 조회 결과
+	var license *models.BusinessLicenseVerification
+	if action == models.REQUEST_APPROVE {
+		if license, err = s.currentLicense(ctx, tx, current); err != nil {
+			return nil, err
+		}
+	}
+
+	// 요청 상태 전이 검증
+	next, err := models.RestaurantRequestMachine.Next(current.Status, action, models.RestaurantRequestTransitionInput{
+		Request:           current,
+		RejectReasonCodes: opts.RejectReasonCodes,
+		License:           license,
+		LicenseOverride:   opts.LicenseOverride,
+	})
 	if err != nil {
-		return nil, utils.NotFound("요청을 찾을 수 없습니다", err)
+		return nil, transitionError(err)
 	}
 
-	// 이미 처리된 요청인지 확인
-	if currentStatus != models.PENDING {
-		return nil, utils.BadRequest(fmt.Sprintf("이미 처리된 요청입니다 (현재 상태: %s)", currentStatus))
+	// 연결된 매장에 대한 부수 효과 검증
+	var restaurant *models.Restaurant
+	var restaurantStatus *models.RestaurantStatus
+	effect, hasEffect := models.RestaurantEffectOf(action, current.Type)
+	if hasEffect {
+		restaurant, err = s.restaurantRepo.LockRestaurant(ctx, tx, current.RestaurantID)
+		if err != nil {
+			return nil, repositoryError(err, "요청에 연결된 매장 조회 실패")
+		}
+
+		nextRestaurant, err := models.RestaurantMachine.Next(restaurant.Status, effect, restaurant)
+		if err != nil {
+			return nil, transitionError(err)
+		}
+		restaurantStatus = &nextRestaurant
+	}
+
+	// 거절 상태에만 거절 사유 유지
+	update := repository.RestaurantRequestStatusUpdate{Status: next}
+	if next == models.REJECTED {
+		update.RejectReason = opts.RejectReason
+		update.RejectReasonCodes = opts.RejectReasonCodes
+		update.RejectNote = opts.RejectNote
 	}
 
 	// 요청 처리 및 처리된 객체 반환
-	result, err := s.restaurantRepo.ProcessRestaurantRequest(ctx, requestID, payload)
+	// 승인/거절은 검토 결정으로 처리자를 기록
+	if next == models.APPROVED || next == models.REJECTED {
+		update.ProcessedBy = &actor.UserID
+	}
+
+	result, err := s.restaurantRepo.UpdateRestaurantRequestStatus(ctx, tx, requestID, current.Version, update)
 	if err != nil {
-		return nil, utils.InternalServerError("매장 생성 요청 처리 실패", err)
+		return nil, repositoryError(err, "매장 요청 처리 실패")
+	}
+
+	// 변경과 같은 트랜잭션에서 감사 로그 기록
+	err = s.writeAudit(ctx, tx, actor, models.AuditActionOf(action), models.AUDIT_TARGET_RESTAURANT_REQUEST, requestID, current, result, update.RejectReason)
+	if err != nil {
+		return nil, err
+	}
+
+	// 사업자등록번호 검증 실패를 무시하고 승인한 경우 조회 결과와 사유를 별도로 기록
+	if next == models.APPROVED && opts.LicenseOverride && current.BusinessLicenseNumber != nil && !license.Passed() {
+		var snapshot interface{}
+		if license != nil {
+			snapshot = license
+		}
+		err = s.writeAudit(ctx, tx, actor, models.AUDIT_REQUEST_LICENSE_OVERRIDE, models.AUDIT_TARGET_RESTAURANT_REQUEST, requestID, snapshot, nil, opts.LicenseOverrideReason)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result.LicenseVerification = license
+
+	// 검토 결정은 같은 트랜잭션에서 점주 알림과 도메인 이벤트 아웃박스에 기록 (롤백 시 함께 취소)
+	if update.ProcessedBy != nil {
+		if err := s.enqueueOwnerNotifications(ctx, tx, result); err != nil {
+			return nil, err
+		}
+
+		eventType := models.EVENT_REQUEST_APPROVED
+		if result.Status == models.REJECTED {
+			eventType = models.EVENT_REQUEST_REJECTED
+		}
+		err = s.recordEvent(ctx, tx, actor, eventType, models.AUDIT_TARGET_RESTAURANT_REQUEST, strconv.Itoa(result.ID), models.RestaurantRequestDecidedData{
+			RequestID:         result.ID,
+			RestaurantID:      result.RestaurantID,
+			UserID:            result.UserID,
+			Type:              result.Type,
+			Status:            result.Status,
+			RejectReasonCodes: result.RejectReasonCodes,
+			RejectReason:      result.RejectReason,
+			ProcessedBy:       result.ProcessedBy,
+			ProcessedAt:       result.ProcessedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if restaurantStatus != nil {
+		if err := s.restaurantRepo.UpdateRestaurantStatus(ctx, tx, current.RestaurantID, *restaurantStatus); err != nil {
+			return nil, repositoryError(err, "매장 상태 변경 실패")
+		}
+
+		before := *restaurant
+		restaurant.Status = *restaurantStatus
+		err = s.writeAudit(ctx, tx, actor, models.AUDIT_RESTAURANT_STATUS_CHANGE, models.AUDIT_TARGET_RESTAURANT, current.RestaurantID, &before, restaurant, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.recordEvent(ctx, tx, actor, models.EVENT_RESTAURANT_STATUS_CHANGED, models.AUDIT_TARGET_RESTAURANT, current.RestaurantID, models.RestaurantStatusChangedData{
+			RestaurantID: current.RestaurantID,
+			From:         before.Status,
+			To:           *restaurantStatus,
+			Action:       effect,
+			RequestID:    &result.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return result, nil
 }
+
+// recordEvent는 주어진 트랜잭션에 도메인 이벤트를 추가합니다.
+func (s *RestaurantService) recordEvent(ctx context.Context, q repository.Querier, actor models.AuditActor, eventType models.DomainEventType, aggregateType models.AuditTargetType, aggregateID string, data interface{}) error {
+	return recordDomainEvent(ctx, s.eventRepo, q, actor, eventType, aggregateType, aggregateID, data)
+}
+
+// recordDomainEvent는 주어진 트랜잭션에 도메인 이벤트를 추가합니다. 이벤트를 발행하는 서비스들이 공통으로 사용합니다.
+func recordDomainEvent(ctx context.Context, eventRepo *repository.EventRepository, q repository.Querier, actor models.AuditActor, eventType models.DomainEventType, aggregateType models.AuditTargetType, aggregateID string, data interface{}) error {
+	id, err := utils.NewUUID()
+	if err != nil {
+		return utils.InternalServerError("도메인 이벤트 생성 실패", err)
+	}
+
+	event, err := models.NewDomainEvent(id, eventType, aggregateType, aggregateID, actor.UserID, data)
+	if err != nil {
+		return utils.InternalServerError("도메인 이벤트 생성 실패", err)
+	}
+
+	if err := eventRepo.Append(ctx, q, event); err != nil {
+		return utils.InternalServerError("도메인 이벤트 저장 실패", err)
+	}
+	return nil
+}
+
+// enqueueOwnerNotifications는 매장 요청 처리 결과 알림을 설정된 채널별로 아웃박스에 추가합니다.
+func (s *RestaurantService) enqueueOwnerNotifications(ctx context.Context, q repository.Querier, request *models.RestaurantRequest) error {
+	tmpl := models.TEMPLATE_REQUEST_APPROVED
+	if request.Status == models.REJECTED {
+		tmpl = models.TEMPLATE_REQUEST_REJECTED
+	}
+
+	payload, err := json.Marshal(models.RestaurantRequestNotification{
+		RequestID:         request.ID,
+		RestaurantID:      request.RestaurantID,
+		Status:            request.Status,
+		RejectReasonCodes: request.RejectReasonCodes,
+		RejectNote:        request.RejectNote,
+		RejectReason:      request.RejectReason,
+	})
+	if err != nil {
+		return utils.InternalServerError("알림 생성 실패", err)
+	}
+
+	channels := models.ParseNotificationChannels(s.config.NotifyChannels)
+	entries := make([]*models.NotificationOutbox, 0, len(channels))
+	for _, channel := range channels {
+		entries = append(entries, &models.NotificationOutbox{
+			UserID:   request.UserID,
+			Channel:  channel,
+			Template: tmpl,
+			Locale:   models.Locale(s.config.NotifyLocale),
+			Payload:  payload,
+		})
+	}
+
+	if err := s.notificationRepo.Enqueue(ctx, q, entries); err != nil {
+		return utils.InternalServerError("알림 저장 실패", err)
+	}
+	return nil
+}
+
+// processOptions는 처리 페이로드를 전이 옵션으로 변환합니다.
+// 거절 사유 코드가 있으면 활성 카탈로그와 대조하고 점주에게 보여줄 거절 사유 문구를 만듭니다.
+func (s *RestaurantService) processOptions(ctx context.Context, payload *models.ProcessRestaurantRequest) (transitionOptions, error) {
+	opts := transitionOptions{
+		Override:              payload.Override,
+		LicenseOverride:       payload.LicenseOverride,
+		LicenseOverrideReason: payload.LicenseOverrideReason,
+	}
+	if payload.Status != models.REJECTED {
+		return opts, nil
+	}
+
+	codes := make([]string, 0, len(payload.RejectReasonCodes))
+	seen := make(map[string]bool, len(payload.RejectReasonCodes))
+	for _, code := range payload.RejectReasonCodes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code != "" && !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+
+	catalogue, err := s.rejectReasonRepo.FindActiveByCodes(ctx, codes)
+	if err != nil {
+		return opts, utils.InternalServerError("거절 사유 코드 조회 실패", err)
+	}
+
+	found := make(map[string]bool, len(catalogue))
+	for _, item := range catalogue {
+		found[item.Code] = true
+	}
+	var unknown []string
+	for _, code := range codes {
+		if !found[code] {
+			unknown = append(unknown, code)
+		}
+	}
+	if len(unknown) > 0 {
+		return opts, utils.BadRequest(fmt.Sprintf("등록되지 않았거나 비활성화된 거절 사유 코드입니다: %s", strings.Join(unknown, ", ")))
+	}
+
+	reason := models.RenderRejectReason(catalogue, payload.RejectNote, models.LOCALE_KO)
+	opts.RejectReason = &reason
+	opts.RejectReasonCodes = codes
+	opts.RejectNote = payload.RejectNote
+	return opts, nil
+}
+
+// writeAudit은 주어진 트랜잭션에 감사 로그를 추가합니다.
+func (s *RestaurantService) writeAudit(ctx context.Context, q repository.Querier, actor models.AuditActor, action models.AuditAction, targetType models.AuditTargetType, targetID string, before, after interface{}, reason *string) error {
+	return writeAuditLog(ctx, s.auditRepo, q, actor, action, targetType, targetID, before, after, reason)
+}
+
+// writeAuditLog는 주어진 트랜잭션에 감사 로그를 추가합니다. 감사 로그를 남기는 서비스들이 공통으로 사용합니다.
+func writeAuditLog(ctx context.Context, auditRepo *repository.AuditRepository, q repository.Querier, actor models.AuditActor, action models.AuditAction, targetType models.AuditTargetType, targetID string, before, after interface{}, reason *string) error {
+	entry, err := models.NewAuditLog(actor, action, targetType, targetID, before, after)
+	if err != nil {
+		return utils.InternalServerError("감사 로그 생성 실패", err)
+	}
+
+	if err := auditRepo.Create(ctx, q, entry.WithReason(reason)); err != nil {
+		return utils.InternalServerError("감사 로그 저장 실패", err)
+	}
+	return nil
+}
+
+// transitionOptions는 매장 요청 전이 시 선택적으로 전달되는 값입니다.
+type transitionOptions struct {
+	RejectReason          *string  // 카탈로그 문구로 만든 거절 사유
+	RejectReasonCodes     []string // 검증된 거절 사유 코드
+	RejectNote            *string  // 검토자 비고
+	ExpectedVersion       *int     // If-Match 헤더의 버전
+	Override              bool     // 슈퍼 관리자의 점유 무시 처리 여부
+	LicenseOverride       bool     // 사업자등록번호 검증 실패 무시 여부
+	LicenseOverrideReason *string  // 사업자등록번호 검증 실패를 무시한 사유
+}
+
+// transitionError는 상태 전이 오류를 API 에러로 변환합니다.
+// 허용되지 않은 전이는 409, 가드 검증 실패는 400으로 응답합니다.
+func transitionError(err error) error {
+	var te *models.TransitionError
+	if errors.As(err, &te) {
+		return utils.Conflict(te.Error(), err)
+	}
+	return utils.BadRequest(err.Error(), err)
+}
+
+// repositoryError는 리포지토리 에러를 API 에러로 변환합니다.
+// 이미 AppError인 경우 그대로 반환합니다.
+func repositoryError(err error, message string) error {
+	var appErr *utils.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, repository.ErrNotFound):
+		return utils.NotFound(err.Error(), err)
+	case errors.Is(err, repository.ErrConflict):
+		return utils.Conflict("다른 요청에 의해 이미 변경되었습니다", err)
+	default:
+		return utils.InternalServerError(message, err)
+	}
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// GetRestaurants는 매장 목록을 검색합니다.
+func (s *RestaurantService) GetRestaurants(ctx context.Context, query dto.RestaurantQuery) (*models.RestaurantsResponse, error) {
+	restaurants, total, err := s.restaurantRepo.GetRestaurants(ctx, query)
+	if err != nil {
+		return nil, utils.InternalServerError("매장 목록 조회 실패", err)
+	}
+
+	return &models.RestaurantsResponse{
+		Restaurants: restaurants,
+		Pagination: models.Pagination{
+			Total:      total,
+			Page:       query.Page,
+			PageSize:   query.PageSize,
+			TotalPages: (total + query.PageSize - 1) / query.PageSize,
+		},
+	}, nil
+}
+
+// GetRestaurant는 매장 상세를 사업자 정보, 영업 시간과 함께 조회합니다. 삭제된 매장도 조회할 수 있습니다.
+func (s *RestaurantService) GetRestaurant(ctx context.Context, restaurantID string) (*models.Restaurant, error) {
+	restaurant, err := s.restaurantRepo.GetRestaurantByID(ctx, restaurantID)
+	if err != nil {
+		return nil, repositoryError(err, "매장 조회 실패")
+	}
+
+	restaurant.Business, err = s.restaurantRepo.GetRestaurantBusiness(ctx, restaurantID)
+	if err != nil {
+		return nil, utils.InternalServerError("사업자 정보 조회 실패", err)
+	}
+
+	restaurant.BusinessHours, err = s.restaurantRepo.GetBusinessHours(ctx, restaurantID)
+	if err != nil {
+		return nil, utils.InternalServerError("영업 시간 조회 실패", err)
+	}
+
+	return restaurant, nil
+}
+
+// UpdateRestaurant는 매장 정보를 수정합니다. 삭제된 매장은 복구한 뒤에 수정할 수 있습니다.
+func (s *RestaurantService) UpdateRestaurant(ctx context.Context, actor models.AuditActor, restaurantID string, payload *models.UpdateRestaurantRequest) (*models.Restaurant, error) {
+	var restaurant *models.Restaurant
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		restaurant, err = s.restaurantRepo.LockRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return repositoryError(err, "매장 조회 실패")
+		}
+
+		if restaurant.DeletedAt != nil {
+			return utils.Conflict("삭제된 매장은 수정할 수 없습니다")
+		}
+
+		before := *restaurant
+		applyRestaurantUpdate(restaurant, payload)
+
+		if err := s.restaurantRepo.UpdateRestaurant(ctx, tx, restaurant); err != nil {
+			return repositoryError(err, "매장 정보 수정 실패")
+		}
+
+		return s.writeAudit(ctx, tx, actor, models.AUDIT_RESTAURANT_UPDATE, models.AUDIT_TARGET_RESTAURANT, restaurantID, &before, restaurant, payload.Reason)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 정보 수정 실패")
+	}
+
+	return restaurant, nil
+}
+
+// DeleteRestaurant는 매장을 삭제(soft delete) 처리합니다.
+func (s *RestaurantService) DeleteRestaurant(ctx context.Context, actor models.AuditActor, restaurantID string, reason string) (*models.Restaurant, error) {
+	var restaurant *models.Restaurant
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		restaurant, err = s.restaurantRepo.LockRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return repositoryError(err, "매장 조회 실패")
+		}
+
+		if restaurant.DeletedAt != nil {
+			return utils.Conflict("이미 삭제된 매장입니다")
+		}
+
+		deletedAt, err := s.restaurantRepo.SoftDeleteRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return repositoryError(err, "매장 삭제 실패")
+		}
+
+		before := *restaurant
+		restaurant.DeletedAt = &deletedAt
+		restaurant.UpdatedAt = deletedAt
+
+		return s.writeAudit(ctx, tx, actor, models.AUDIT_RESTAURANT_DELETE, models.AUDIT_TARGET_RESTAURANT, restaurantID, &before, restaurant, &reason)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 삭제 실패")
+	}
+
+	return restaurant, nil
+}
+
+// RestoreRestaurant는 삭제된 매장을 복구합니다.
+func (s *RestaurantService) RestoreRestaurant(ctx context.Context, actor models.AuditActor, restaurantID string, reason string) (*models.Restaurant, error) {
+	var restaurant *models.Restaurant
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		restaurant, err = s.restaurantRepo.LockRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return repositoryError(err, "매장 조회 실패")
+		}
+
+		if restaurant.DeletedAt == nil {
+			return utils.Conflict("삭제되지 않은 매장입니다")
+		}
+
+		if err := s.restaurantRepo.RestoreRestaurant(ctx, tx, restaurantID); err != nil {
+			return repositoryError(err, "매장 복구 실패")
+		}
+
+		before := *restaurant
+		restaurant.DeletedAt = nil
+
+		return s.writeAudit(ctx, tx, actor, models.AUDIT_RESTAURANT_RESTORE, models.AUDIT_TARGET_RESTAURANT, restaurantID, &before, restaurant, &reason)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 복구 실패")
+	}
+
+	return restaurant, nil
+}
+
+// applyRestaurantUpdate는 수정 페이로드에 포함된 필드만 매장에 반영합니다.
+// 선택 항목에 빈 문자열이 오면 값을 비웁니다.
+func applyRestaurantUpdate(restaurant *models.Restaurant, payload *models.UpdateRestaurantRequest) {
+	if payload.Name != nil {
+		restaurant.Name = *payload.Name
+	}
+	if payload.Address != nil {
+		restaurant.Address = *payload.Address
+	}
+	if payload.PhoneNumber != nil {
+		restaurant.PhoneNumber = utils.NormalizePhoneNumber(*payload.PhoneNumber)
+	}
+	if payload.ParkingAvailable != nil {
+		restaurant.ParkingAvailable = *payload.ParkingAvailable
+	}
+	if payload.DeliveryAvailable != nil {
+		restaurant.DeliveryAvailable = *payload.DeliveryAvailable
+	}
+
+	optional := []struct {
+		value  *string
+		target **string
+	}{
+		{payload.Description, &restaurant.Description},
+		{payload.AddressDescription, &restaurant.AddressDescription},
+		{payload.EventDescription, &restaurant.EventDescription},
+		{payload.Holiday, &restaurant.Holiday},
+		{payload.ParkingDescription, &restaurant.ParkingDescription},
+	}
+	for _, field := range optional {
+		if field.value == nil {
+			continue
+		}
+		if *field.value == "" {
+			*field.target = nil
+			continue
+		}
+		value := *field.value
+		*field.target = &value
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	"lambda-go/pkg/utils"
+)
+
+// GetRestaurantRequestStats는 매장 요청 큐 현황, 처리 소요 시간, SLA 초과, 검토자별 통계를 조회합니다.
+// 모든 집계는 SQL에서 계산됩니다.
+func (s *RestaurantService) GetRestaurantRequestStats(ctx context.Context, query dto.RestaurantRequestStatsQuery) (*models.RestaurantRequestStatsResponse, error) {
+	if query.SLA <= 0 {
+		query.SLA = s.config.RequestSLA
+	}
+	if !query.From.Before(query.To) {
+		return nil, utils.BadRequest("from은 to보다 이전이어야 합니다")
+	}
+
+	queueDepth, err := s.restaurantRepo.GetRequestQueueDepth(ctx)
+	if err != nil {
+		return nil, utils.InternalServerError("큐 현황 조회 실패", err)
+	}
+
+	decisionTime, decidedBreaches, err := s.restaurantRepo.GetRequestDecisionTime(ctx, query)
+	if err != nil {
+		return nil, utils.InternalServerError("처리 소요 시간 조회 실패", err)
+	}
+
+	pendingBreaches, oldest, err := s.restaurantRepo.GetPendingSLABreaches(ctx, query)
+	if err != nil {
+		return nil, utils.InternalServerError("SLA 초과 요청 조회 실패", err)
+	}
+
+	reviewers, err := s.restaurantRepo.GetReviewerStats(ctx, query)
+	if err != nil {
+		return nil, utils.InternalServerError("검토자 통계 조회 실패", err)
+	}
+
+	return &models.RestaurantRequestStatsResponse{
+		From:           query.From,
+		To:             query.To,
+		QueueDepth:     queueDepth,
+		TimeToDecision: decisionTime,
+		SLA: models.SLAStats{
+			SLAHours:        query.SLA.Hours(),
+			PendingBreaches: pendingBreaches,
+			DecidedBreaches: decidedBreaches,
+			OldestBreaches:  oldest,
+		},
+		Reviewers: reviewers,
+	}, nil
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	repository "lambda-go/pkg/repositories"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// TagService는 태그 분류 체계 관리와 매장 태그 지정 서비스를 제공합니다.
+type TagService struct {
+	config         *config.Config
+	txManager      *repository.TxManager
+	tagRepo        *repository.TagRepository
+	restaurantRepo *repository.RestaurantRepository
+	auditRepo      *repository.AuditRepository
+}
+
+// NewTagService는 새 TagService 인스턴스를 생성합니다.
+func NewTagService(cfg *config.Config, txManager *repository.TxManager, tagRepo *repository.TagRepository, restaurantRepo *repository.RestaurantRepository, auditRepo *repository.AuditRepository) *TagService {
+	return &TagService{
+		config:         cfg,
+		txManager:      txManager,
+		tagRepo:        tagRepo,
+		restaurantRepo: restaurantRepo,
+		auditRepo:      auditRepo,
+	}
+}
+
+// GetTags는 태그 목록을 사용 매장 수와 함께 조회합니다.
+func (s *TagService) GetTags(ctx context.Context, query dto.TagQuery) (*models.TagsResponse, error) {
+	tags, total, err := s.tagRepo.List(ctx, query)
+	if err != nil {
+		return nil, utils.InternalServerError("태그 목록 조회 실패", err)
+	}
+
+	return &models.TagsResponse{
+		Tags: tags,
+		Pagination: models.Pagination{
+			Total:      total,
+			Page:       query.Page,
+			PageSize:   query.PageSize,
+			TotalPages: (total + query.PageSize - 1) / query.PageSize,
+		},
+	}, nil
+}
+
+// GetTag는 태그를 사용 매장 수와 함께 조회합니다.
+func (s *TagService) GetTag(ctx context.Context, tagID int) (*models.Tag, error) {
+	tag, err := s.tagRepo.GetByID(ctx, tagID)
+	if err != nil {
+		return nil, repositoryError(err, "태그 조회 실패")
+	}
+	return tag, nil
+}
+
+// CreateTag는 태그를 추가합니다. 대소문자와 공백만 다른 이름의 태그가 있으면 409로 응답합니다.
+func (s *TagService) CreateTag(ctx context.Context, actor models.AuditActor, payload *models.CreateTagRequest) (*models.Tag, error) {
+	name := normalizeTagName(payload.Name)
+	if name == "" {
+		return nil, utils.BadRequest("태그 이름은 공백일 수 없습니다")
+	}
+
+	tag := &models.Tag{
+		Name:        name,
+		Description: emptyToNil(payload.Description),
+	}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		if err := s.tagRepo.Create(ctx, tx, tag); err != nil {
+			return tagError(err, "태그 생성 실패")
+		}
+		return writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_TAG_CREATE, models.AUDIT_TARGET_TAG, fmt.Sprint(tag.ID), nil, tag, nil)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "태그 생성 실패")
+	}
+
+	usageCount := 0
+	tag.UsageCount = &usageCount
+	return tag, nil
+}
+
+// UpdateTag는 태그의 이름과 설명을 변경합니다.
+func (s *TagService) UpdateTag(ctx context.Context, actor models.AuditActor, tagID int, payload *models.UpdateTagRequest) (*models.Tag, error) {
+	var result *models.Tag
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		tag, err := s.lockTag(ctx, tx, tagID)
+		if err != nil {
+			return err
+		}
+
+		before := *tag
+		if payload.Name != nil {
+			name := normalizeTagName(*payload.Name)
+			if name == "" {
+				return utils.BadRequest("태그 이름은 공백일 수 없습니다")
+			}
+			tag.Name = name
+		}
+		if payload.Description != nil {
+			tag.Description = emptyToNil(payload.Description)
+		}
+
+		if err := s.tagRepo.Update(ctx, tx, tag); err != nil {
+			return tagError(err, "태그 수정 실패")
+		}
+
+		if err := writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_TAG_UPDATE, models.AUDIT_TARGET_TAG, fmt.Sprint(tagID), &before, tag, nil); err != nil {
+			return err
+		}
+
+		result, err = s.tagRepo.FindByID(ctx, tx, tagID)
+		if err != nil {
+			return repositoryError(err, "태그 조회 실패")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, repositoryError(err, "태그 수정 실패")
+	}
+
+	return result, nil
+}
+
+// DeleteTag는 태그를 삭제합니다. 태그의 매장 연결도 함께 삭제됩니다.
+func (s *TagService) DeleteTag(ctx context.Context, actor models.AuditActor, tagID int) (*models.Tag, error) {
+	var result *models.Tag
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		if _, err := s.lockTag(ctx, tx, tagID); err != nil {
+			return err
+		}
+
+		tag, err := s.tagRepo.FindByID(ctx, tx, tagID)
+		if err != nil {
+			return repositoryError(err, "태그 조회 실패")
+		}
+
+		if _, err := s.tagRepo.Delete(ctx, tx, tagID); err != nil {
+			return repositoryError(err, "태그 삭제 실패")
+		}
+
+		result = tag
+		return writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_TAG_DELETE, models.AUDIT_TARGET_TAG, fmt.Sprint(tagID), tag, nil, nil)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "태그 삭제 실패")
+	}
+
+	return result, nil
+}
+
+// MergeTags는 원본 태그들을 대상 태그로 합칩니다.
+// 원본 태그의 매장 연결은 한 트랜잭션 안에서 대상 태그로 옮겨지고 원본 태그는 삭제됩니다.
+func (s *TagService) MergeTags(ctx context.Context, actor models.AuditActor, targetID int, payload *models.MergeTagsRequest) (*models.TagMergeResponse, error) {
+	sourceIDs := uniqueTagIDs(payload.SourceIDs)
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, utils.BadRequest("대상 태그는 병합할 태그 목록에 포함될 수 없습니다")
+		}
+	}
+
+	result := &models.TagMergeResponse{MergedTagIDs: sourceIDs}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		// 대상과 원본을 ID 순서대로 한 번에 잠가 교착 상태를 피함
+		locked, err := s.tagRepo.LockByIDs(ctx, tx, append([]int{targetID}, sourceIDs...), true)
+		if err != nil {
+			return utils.InternalServerError("태그 조회 실패", err)
+		}
+		if missing := missingTagIDs(append([]int{targetID}, sourceIDs...), locked); len(missing) > 0 {
+			return utils.NotFound(fmt.Sprintf("존재하지 않는 태그입니다: %v", missing))
+		}
+
+		before, err := s.tagRepo.FindByID(ctx, tx, targetID)
+		if err != nil {
+			return repositoryError(err, "태그 조회 실패")
+		}
+		var sources []models.Tag
+		for _, tag := range locked {
+			if tag.ID != targetID {
+				sources = append(sources, tag)
+			}
+		}
+
+		moved, duplicates, err := s.tagRepo.Merge(ctx, tx, targetID, sourceIDs)
+		if err != nil {
+			return utils.InternalServerError("태그 병합 실패", err)
+		}
+		result.MovedCount = int(moved)
+		result.DuplicateCount = int(duplicates)
+
+		result.Tag, err = s.tagRepo.FindByID(ctx, tx, targetID)
+		if err != nil {
+			return repositoryError(err, "태그 조회 실패")
+		}
+
+		return writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_TAG_MERGE, models.AUDIT_TARGET_TAG, fmt.Sprint(targetID),
+			map[string]interface{}{"target": before, "sources": sources}, result, nil)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "태그 병합 실패")
+	}
+
+	return result, nil
+}
+
+// GetRestaurantTags는 매장에 붙은 태그를 조회합니다.
+func (s *TagService) GetRestaurantTags(ctx context.Context, restaurantID string) ([]models.Tag, error) {
+	if _, err := s.restaurantRepo.GetRestaurantByID(ctx, restaurantID); err != nil {
+		return nil, repositoryError(err, "매장 조회 실패")
+	}
+
+	tags, err := s.tagRepo.GetByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, utils.InternalServerError("매장 태그 조회 실패", err)
+	}
+	return tags, nil
+}
+
+// AssignRestaurantTags는 매장에 태그들을 붙이고 매장의 전체 태그를 반환합니다.
+func (s *TagService) AssignRestaurantTags(ctx context.Context, actor models.AuditActor, restaurantID string, payload *models.AssignRestaurantTagsRequest) ([]models.Tag, error) {
+	tagIDs := uniqueTagIDs(payload.TagIDs)
+
+	return s.changeRestaurantTags(ctx, actor, restaurantID, models.AUDIT_RESTAURANT_TAG_ASSIGN, func(tx pgx.Tx) error {
+		locked, err := s.tagRepo.LockByIDs(ctx, tx, tagIDs, false)
+		if err != nil {
+			return utils.InternalServerError("태그 조회 실패", err)
+		}
+		if missing := missingTagIDs(tagIDs, locked); len(missing) > 0 {
+			return utils.NotFound(fmt.Sprintf("존재하지 않는 태그입니다: %v", missing))
+		}
+
+		if _, err := s.tagRepo.Assign(ctx, tx, restaurantID, tagIDs); err != nil {
+			return utils.InternalServerError("매장 태그 지정 실패", err)
+		}
+		return nil
+	})
+}
+
+// RemoveRestaurantTag는 매장에서 태그를 떼고 매장의 남은 태그를 반환합니다.
+func (s *TagService) RemoveRestaurantTag(ctx context.Context, actor models.AuditActor, restaurantID string, tagID int) ([]models.Tag, error) {
+	return s.changeRestaurantTags(ctx, actor, restaurantID, models.AUDIT_RESTAURANT_TAG_REMOVE, func(tx pgx.Tx) error {
+		if err := s.tagRepo.Unassign(ctx, tx, restaurantID, tagID); err != nil {
+			return repositoryError(err, "매장 태그 삭제 실패")
+		}
+		return nil
+	})
+}
+
+// changeRestaurantTags는 매장 행을 잠근 뒤 태그 변경 함수를 적용하고 변경 전후 태그 목록을 감사 로그에 남깁니다.
+func (s *TagService) changeRestaurantTags(ctx context.Context, actor models.AuditActor, restaurantID string, action models.AuditAction, apply func(tx pgx.Tx) error) ([]models.Tag, error) {
+	var result []models.Tag
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		restaurant, err := s.restaurantRepo.LockRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return repositoryError(err, "매장 조회 실패")
+		}
+		if restaurant.DeletedAt != nil {
+			return utils.Conflict("삭제된 매장의 태그는 수정할 수 없습니다")
+		}
+
+		before, err := s.tagRepo.FindByRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return utils.InternalServerError("매장 태그 조회 실패", err)
+		}
+
+		if err := apply(tx); err != nil {
+			return err
+		}
+
+		result, err = s.tagRepo.FindByRestaurant(ctx, tx, restaurantID)
+		if err != nil {
+			return utils.InternalServerError("매장 태그 조회 실패", err)
+		}
+
+		return writeAuditLog(ctx, s.auditRepo, tx, actor, action, models.AUDIT_TARGET_RESTAURANT, restaurantID, before, result, nil)
+	})
+	if err != nil {
+		return nil, repositoryError(err, "매장 태그 변경 실패")
+	}
+
+	return result, nil
+}
+
+// lockTag는 태그 행을 잠그고 조회합니다. 없으면 404로 응답합니다.
+func (s *TagService) lockTag(ctx context.Context, tx pgx.Tx, tagID int) (*models.Tag, error) {
+	tags, err := s.tagRepo.LockByIDs(ctx, tx, []int{tagID}, true)
+	if err != nil {
+		return nil, utils.InternalServerError("태그 조회 실패", err)
+	}
+	if len(tags) == 0 {
+		return nil, utils.NotFound(fmt.Sprintf("태그 ID %d를 찾을 수 없습니다", tagID))
+	}
+	return &tags[0], nil
+}
+
+// normalizeTagName은 태그 이름의 앞뒤 공백을 제거하고 연속 공백을 하나로 줄입니다.
+// 대소문자는 입력한 그대로 저장하며, 중복 확인은 DB의 normalize_tag_name으로 대소문자를 무시합니다.
+func normalizeTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// emptyToNil은 빈 문자열(공백만 있는 경우 포함)을 nil로 바꿉니다.
+func emptyToNil(value *string) *string {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	return &trimmed
+}
+
+// uniqueTagIDs는 태그 ID 목록의 중복을 제거하고 오름차순으로 정렬합니다.
+func uniqueTagIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+// missingTagIDs는 요청한 태그 ID 중 조회되지 않은 ID를 반환합니다.
+func missingTagIDs(ids []int, found []models.Tag) []int {
+	exists := make(map[int]bool, len(found))
+	for _, tag := range found {
+		exists[tag.ID] = true
+	}
+
+	var missing []int
+	for _, id := range ids {
+		if !exists[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// tagError는 태그 이름 중복을 알아보기 쉬운 메시지로 변환합니다.
+func tagError(err error, message string) error {
+	if errors.Is(err, repository.ErrConflict) {
+		return utils.Conflict("이미 같은 이름의 태그가 있습니다 (대소문자, 공백 무시)", err)
+	}
+	return repositoryError(err, message)
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	dto "lambda-go/pkg/models/dtos"
+	repository "lambda-go/pkg/repositories"
+	"lambda-go/pkg/utils"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// userDetailLimit는 사용자 상세에 포함하는 최근 매장/주문/매장 요청 수입니다.
+const userDetailLimit = 10
+
+// UserService는 관리자 사용자 조회, 계정 정지, 역할 변경 서비스를 제공합니다.
+type UserService struct {
+	config         *config.Config
+	txManager      *repository.TxManager
+	userRepo       *repository.UserRepository
+	restaurantRepo *repository.RestaurantRepository
+	orderRepo      *repository.OrderRepository
+	eventRepo      *repository.EventRepository
+	auditRepo      *repository.AuditRepository
+}
+
+// NewUserService는 새 UserService 인스턴스를 생성합니다.
+func NewUserService(cfg *config.Config, txManager *repository.TxManager, userRepo *repository.UserRepository, restaurantRepo *repository.RestaurantRepository, orderRepo *repository.OrderRepository, eventRepo *repository.EventRepository, auditRepo *repository.AuditRepository) *UserService {
+	return &UserService{
+		config:         cfg,
+		txManager:      txManager,
+		userRepo:       userRepo,
+		restaurantRepo: restaurantRepo,
+		orderRepo:      orderRepo,
+		eventRepo:      eventRepo,
+		auditRepo:      auditRepo,
+	}
+}
+
+// GetUsers는 사용자 목록을 검색합니다.
+func (s *UserService) GetUsers(ctx context.Context, query dto.UserQuery) (*models.UsersResponse, error) {
+	users, total, err := s.userRepo.GetUsers(ctx, query)
+	if err != nil {
+		return nil, utils.InternalServerError("사용자 목록 조회 실패", err)
+	}
+
+	return &models.UsersResponse{
+		Users: users,
+		Pagination: models.Pagination{
+			Total:      total,
+			Page:       query.Page,
+			PageSize:   query.PageSize,
+			TotalPages: (total + query.PageSize - 1) / query.PageSize,
+		},
+	}, nil
+}
+
+// GetUser는 사용자 상세를 소유 매장, 최근 주문, 매장 요청과 함께 조회합니다.
+func (s *UserService) GetUser(ctx context.Context, userID string) (*models.UserDetailResponse, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, repositoryError(err, "사용자 조회 실패")
+	}
+
+	detail := &models.UserDetailResponse{User: user}
+
+	detail.ActiveSessions, err = s.userRepo.CountAdminSessions(ctx, userID)
+	if err != nil {
+		return nil, utils.InternalServerError("관리자 세션 조회 실패", err)
+	}
+
+	detail.Restaurants, detail.RestaurantCount, err = s.restaurantRepo.GetRestaurants(ctx, dto.RestaurantQuery{
+		Page:     1,
+		PageSize: userDetailLimit,
+		OwnerID:  &userID,
+		Deleted:  dto.DELETED_INCLUDE,
+	})
+	if err != nil {
+		return nil, utils.InternalServerError("매장 목록 조회 실패", err)
+	}
+
+	detail.RecentOrders, detail.OrderCount, err = s.orderRepo.GetOrders(ctx, dto.OrderQuery{
+		Page:       1,
+		PageSize:   userDetailLimit,
+		CustomerID: &userID,
+	})
+	if err != nil {
+		return nil, utils.InternalServerError("주문 목록 조회 실패", err)
+	}
+
+	detail.Requests, detail.RequestCount, err = s.restaurantRepo.GetRestaurantRequests(ctx, dto.RestaurantRequestQuery{
+		Page:     1,
+		PageSize: userDetailLimit,
+		UserID:   &userID,
+	})
+	if err != nil {
+		return nil, utils.InternalServerError("매장 요청 목록 조회 실패", err)
+	}
+	if detail.Requests == nil {
+		detail.Requests = []models.RestaurantRequest{}
+	}
+
+	return detail, nil
+}
+
+// SuspendUser는 사용자 계정을 정지하고 관리자 세션을 모두 삭제합니다.
+// 관리자 계정은 슈퍼 관리자만 정지할 수 있으며, 자기 자신은 정지할 수 없습니다.
+func (s *UserService) SuspendUser(ctx context.Context, actor models.AuditActor, userID string, reason string) (*models.User, error) {
+	if userID == actor.UserID {
+		return nil, utils.BadRequest("자기 자신의 계정은 정지할 수 없습니다")
+	}
+
+	var user *models.User
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		user, err = s.userRepo.LockUser(ctx, tx, userID)
+		if err != nil {
+			return repositoryError(err, "사용자 조회 실패")
+		}
+		if err := s.checkAdminTarget(actor, user); err != nil {
+			return err
+		}
+		if user.IsSuspended() {
+			return utils.Conflict("이미 정지된 계정입니다")
+		}
+
+		suspendedAt, err := s.userRepo.SuspendUser(ctx, tx, userID, reason, actor.UserID)
+		if err != nil {
+			return repositoryError(err, "사용자 정지 실패")
+		}
+
+		revoked, err := s.userRepo.RevokeAdminSessions(ctx, tx, userID)
+		if err != nil {
+			return utils.InternalServerError("관리자 세션 삭제 실패", err)
+		}
+
+		before := *user
+		user.SuspendedAt = &suspendedAt
+		user.SuspendedBy = &actor.UserID
+		user.SuspendReason = &reason
+
+		err = writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_USER_SUSPEND, models.AUDIT_TARGET_USER, userID, &before, user, &reason)
+		if err != nil {
+			return err
+		}
+
+		return recordDomainEvent(ctx, s.eventRepo, tx, actor, models.EVENT_USER_SUSPENDED, models.AUDIT_TARGET_USER, userID, models.UserSuspensionChangedData{
+			UserID:          userID,
+			Suspended:       true,
+			Reason:          reason,
+			ChangedBy:       actor.UserID,
+			ChangedAt:       suspendedAt,
+			RevokedSessions: revoked,
+		})
+	})
+	if err != nil {
+		return nil, repositoryError(err, "사용자 정지 실패")
+	}
+
+	return user, nil
+}
+
+// UnsuspendUser는 사용자 계정 정지를 해제합니다. 삭제된 세션은 복구되지 않으므로 다시 로그인해야 합니다.
+func (s *UserService) UnsuspendUser(ctx context.Context, actor models.AuditActor, userID string, reason string) (*models.User, error) {
+	var user *models.User
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		user, err = s.userRepo.LockUser(ctx, tx, userID)
+		if err != nil {
+			return repositoryError(err, "사용자 조회 실패")
+		}
+		if err := s.checkAdminTarget(actor, user); err != nil {
+			return err
+		}
+		if !user.IsSuspended() {
+			return utils.Conflict("정지된 계정이 아닙니다")
+		}
+
+		if err := s.userRepo.UnsuspendUser(ctx, tx, userID); err != nil {
+			return repositoryError(err, "사용자 정지 해제 실패")
+		}
+
+		before := *user
+		user.SuspendedAt = nil
+		user.SuspendedBy = nil
+		user.SuspendReason = nil
+
+		err = writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_USER_UNSUSPEND, models.AUDIT_TARGET_USER, userID, &before, user, &reason)
+		if err != nil {
+			return err
+		}
+
+		return recordDomainEvent(ctx, s.eventRepo, tx, actor, models.EVENT_USER_UNSUSPENDED, models.AUDIT_TARGET_USER, userID, models.UserSuspensionChangedData{
+			UserID:    userID,
+			Suspended: false,
+			Reason:    reason,
+			ChangedBy: actor.UserID,
+			ChangedAt: time.Now().UTC(),
+		})
+	})
+	if err != nil {
+		return nil, repositoryError(err, "사용자 정지 해제 실패")
+	}
+
+	return user, nil
+}
+
+// ChangeUserRole은 사용자 역할을 변경하고 관리자 세션을 모두 삭제합니다.
+// 역할 변경은 슈퍼 관리자만 할 수 있으며, 자기 자신의 역할은 변경할 수 없습니다.
+func (s *UserService) ChangeUserRole(ctx context.Context, actor models.AuditActor, userID string, payload *models.ChangeUserRoleRequest) (*models.User, error) {
+	if !s.config.IsSuperAdmin(actor.UserID) {
+		return nil, utils.Forbidden("역할 변경은 슈퍼 관리자만 할 수 있습니다")
+	}
+	if userID == actor.UserID {
+		return nil, utils.BadRequest("자기 자신의 역할은 변경할 수 없습니다")
+	}
+
+	var user *models.User
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		user, err = s.userRepo.LockUser(ctx, tx, userID)
+		if err != nil {
+			return repositoryError(err, "사용자 조회 실패")
+		}
+		if user.Role == payload.Role {
+			return utils.Conflict("이미 같은 역할입니다")
+		}
+
+		if err := s.userRepo.UpdateUserRole(ctx, tx, userID, payload.Role); err != nil {
+			return repositoryError(err, "사용자 역할 변경 실패")
+		}
+
+		revoked, err := s.userRepo.RevokeAdminSessions(ctx, tx, userID)
+		if err != nil {
+			return utils.InternalServerError("관리자 세션 삭제 실패", err)
+		}
+
+		before := *user
+		user.Role = payload.Role
+
+		err = writeAuditLog(ctx, s.auditRepo, tx, actor, models.AUDIT_USER_ROLE_CHANGE, models.AUDIT_TARGET_USER, userID, &before, user, &payload.Reason)
+		if err != nil {
+			return err
+		}
+
+		return recordDomainEvent(ctx, s.eventRepo, tx, actor, models.EVENT_USER_ROLE_CHANGED, models.AUDIT_TARGET_USER, userID, models.UserRoleChangedData{
+			UserID:          userID,
+			From:            before.Role,
+			To:              payload.Role,
+			Reason:          payload.Reason,
+			ChangedBy:       actor.UserID,
+			RevokedSessions: revoked,
+		})
+	})
+	if err != nil {
+		return nil, repositoryError(err, "사용자 역할 변경 실패")
+	}
+
+	return user, nil
+}
+
+// checkAdminTarget은 관리자 또는 슈퍼 관리자 계정을 대상으로 한 처리를 슈퍼 관리자로 제한합니다.
+func (s *UserService) checkAdminTarget(actor models.AuditActor, user *models.User) error {
+	if (user.Role == models.ADMIN || s.config.IsSuperAdmin(user.ID)) && !s.config.IsSuperAdmin(actor.UserID) {
+		return utils.Forbidden("관리자 계정은 슈퍼 관리자만 정지하거나 정지 해제할 수 있습니다")
+	}
+	return nil
+}
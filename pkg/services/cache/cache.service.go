@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	config "lambda-go/pkg/configs"
+)
+
+// Cache는 직렬화된 조회 결과를 보관하는 캐시 인터페이스입니다.
+// 캐시는 성능을 위한 것이므로 호출자는 Get/Set 실패 시 원본 조회로 대체해야 합니다.
+type Cache interface {
+	Name() string
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// NewCache는 설정된 분석 결과 캐시를 생성합니다.
+func NewCache(analyticsCfg *config.AnalyticsConfig) (Cache, error) {
+	switch strings.ToLower(analyticsCfg.Cache) {
+	case "memory":
+		return NewMemoryCache(analyticsCfg.CacheMaxEntries), nil
+	case "none", "":
+		return NoopCache{}, nil
+	default:
+		return nil, fmt.Errorf("알 수 없는 캐시입니다: %s", analyticsCfg.Cache)
+	}
+}
+
+// NoopCache는 아무것도 보관하지 않는 캐시입니다 (캐시 비활성화).
+type NoopCache struct{}
+
+func (NoopCache) Name() string {
+	return "none"
+}
+
+func (NoopCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
+func (NoopCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return nil
+}
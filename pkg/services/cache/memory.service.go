@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryCache는 프로세스 메모리에 결과를 보관하는 캐시입니다.
+// Lambda 웜 스타트 동안만 유지되며, 인스턴스마다 따로 보관됩니다.
+type MemoryCache struct {
+	mu         sync.Mutex
+	entries    map[string]memoryEntry
+	maxEntries int
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache는 새 MemoryCache 인스턴스를 생성합니다. maxEntries가 0 이하면 개수를 제한하지 않습니다.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		entries:    map[string]memoryEntry{},
+		maxEntries: maxEntries,
+	}
+}
+
+func (c *MemoryCache) Name() string {
+	return "memory"
+}
+
+// Get은 만료되지 않은 값을 반환합니다.
+func (c *MemoryCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+// Set은 값을 ttl 동안 보관합니다. 최대 개수를 넘으면 만료된 항목을 먼저 지우고, 그래도 넘으면 가장 먼저 만료될 항목을 지웁니다.
+func (c *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if _, exists := c.entries[key]; !exists && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+
+	c.entries[key] = memoryEntry{value: value, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// evict는 만료된 항목을 지우고, 공간이 없으면 가장 먼저 만료될 항목 하나를 지웁니다.
+func (c *MemoryCache) evict(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.expiresAt.Before(oldest) {
+			oldestKey, oldest = key, entry.expiresAt
+		}
+	}
+	if len(c.entries) >= c.maxEntries && oldestKey != "" {
+		delete(c.entries, oldestKey)
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"lambda-go/pkg/models"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+)
+
+// EventBridgeSink는 도메인 이벤트를 EventBridge 이벤트 버스로 발행합니다.
+// EventBridge는 중복 제거를 하지 않으므로 구독자는 detail.id로 중복을 제거해야 합니다.
+type EventBridgeSink struct {
+	client  *eventbridge.Client
+	busName string
+	source  string
+}
+
+// NewEventBridgeSink는 새 EventBridgeSink 인스턴스를 생성합니다.
+func NewEventBridgeSink(client *eventbridge.Client, busName, source string) *EventBridgeSink {
+	return &EventBridgeSink{
+		client:  client,
+		busName: busName,
+		source:  source,
+	}
+}
+
+func (s *EventBridgeSink) Name() string {
+	return "eventbridge"
+}
+
+// Publish는 이벤트 종류를 detail-type으로 하여 이벤트를 발행합니다.
+func (s *EventBridgeSink) Publish(ctx context.Context, event models.DomainEvent) error {
+	body, err := eventBody(event)
+	if err != nil {
+		return err
+	}
+
+	out, err := s.client.PutEvents(ctx, &eventbridge.PutEventsInput{
+		Entries: []types.PutEventsRequestEntry{{
+			EventBusName: aws.String(s.busName),
+			Source:       aws.String(s.source),
+			DetailType:   aws.String(string(event.Type)),
+			Detail:       aws.String(body),
+			Resources:    []string{},
+			Time:         aws.Time(event.OccurredAt),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("EventBridge 발행 실패: %w", err)
+	}
+
+	// PutEvents는 항목별 실패를 응답 본문으로 알려줌
+	if out.FailedEntryCount > 0 && len(out.Entries) > 0 {
+		entry := out.Entries[0]
+		return fmt.Errorf("EventBridge 발행 실패: %s %s", aws.ToString(entry.ErrorCode), aws.ToString(entry.ErrorMessage))
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"lambda-go/pkg/models"
+)
+
+// FileSink는 도메인 이벤트를 JSON Lines 파일에 추가합니다 (로컬 개발/테스트용).
+type FileSink struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileSink는 새 FileSink 인스턴스를 생성합니다.
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+// Publish는 이벤트를 한 줄의 JSON으로 파일 끝에 기록합니다.
+func (s *FileSink) Publish(ctx context.Context, event models.DomainEvent) error {
+	body, err := eventBody(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("이벤트 파일 열기 실패: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(body + "\n"); err != nil {
+		return fmt.Errorf("이벤트 파일 기록 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"lambda-go/pkg/models"
+)
+
+// MemorySink는 도메인 이벤트를 메모리에 보관합니다 (테스트용).
+// 같은 ID의 이벤트는 한 번만 보관하여 구독자의 멱등 처리를 흉내 냅니다.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []models.DomainEvent
+	seen   map[string]bool
+}
+
+// NewMemorySink는 새 MemorySink 인스턴스를 생성합니다.
+func NewMemorySink() *MemorySink {
+	return &MemorySink{seen: map[string]bool{}}
+}
+
+func (s *MemorySink) Name() string {
+	return "memory"
+}
+
+// Publish는 처음 받은 이벤트만 보관합니다.
+func (s *MemorySink) Publish(ctx context.Context, event models.DomainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.seen[event.ID] {
+		s.seen[event.ID] = true
+		s.events = append(s.events, event)
+	}
+	return nil
+}
+
+// Events는 보관된 이벤트 목록을 반환합니다.
+func (s *MemorySink) Events() []models.DomainEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]models.DomainEvent(nil), s.events...)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"lambda-go/pkg/models"
+)
+
+func TestMemorySinkPublishKeepsFirstEventPerID(t *testing.T) {
+	sink := NewMemorySink()
+	ctx := context.Background()
+
+	events := []models.DomainEvent{
+		{ID: "e1", Type: models.DomainEventType("A")},
+		{ID: "e2", Type: models.DomainEventType("B")},
+		{ID: "e1", Type: models.DomainEventType("C")}, // 재발행
+	}
+	for _, event := range events {
+		if err := sink.Publish(ctx, event); err != nil {
+			t.Fatalf("Publish() 오류: %v", err)
+		}
+	}
+
+	got := sink.Events()
+	if len(got) != 2 {
+		t.Fatalf("보관된 이벤트 %d건, want 2건", len(got))
+	}
+	if got[0].ID != "e1" || got[0].Type != "A" || got[1].ID != "e2" {
+		t.Errorf("Events() = %+v, 처음 받은 e1(A), e2 순서여야 합니다", got)
+	}
+}
+
+func TestMemorySinkEventsReturnsCopy(t *testing.T) {
+	sink := NewMemorySink()
+	if err := sink.Publish(context.Background(), models.DomainEvent{ID: "e1"}); err != nil {
+		t.Fatalf("Publish() 오류: %v", err)
+	}
+
+	got := sink.Events()
+	got[0].ID = "changed"
+
+	if sink.Events()[0].ID != "e1" {
+		t.Error("Events()가 반환한 슬라이스를 고치면 보관된 이벤트가 바뀌면 안 됩니다")
+	}
+}
+
+func TestMemorySinkConcurrentPublish(t *testing.T) {
+	sink := NewMemorySink()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = sink.Publish(ctx, models.DomainEvent{ID: "same"})
+		}()
+	}
+	wg.Wait()
+
+	if n := len(sink.Events()); n != 1 {
+		t.Errorf("같은 ID를 동시에 발행해도 1건만 보관해야 합니다: %d건", n)
+	}
+}
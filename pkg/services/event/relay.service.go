@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	repository "lambda-go/pkg/repositories"
+)
+
+// maxRetryDelay는 재시도 간격의 상한입니다.
+const maxRetryDelay = 30 * time.Minute
+
+// Relay는 도메인 이벤트 아웃박스의 커밋된 이벤트를 싱크로 발행합니다.
+type Relay struct {
+	eventConfig *config.EventConfig
+	eventRepo   *repository.EventRepository
+	sink        Sink
+}
+
+// NewRelay는 새 Relay 인스턴스를 생성합니다.
+func NewRelay(eventCfg *config.EventConfig, eventRepo *repository.EventRepository, sink Sink) *Relay {
+	return &Relay{
+		eventConfig: eventCfg,
+		eventRepo:   eventRepo,
+		sink:        sink,
+	}
+}
+
+// Relay는 발행 시간이 된 이벤트를 기록 순서대로 한 배치 발행합니다.
+// LeaseDue가 같은 대상의 앞선 PENDING 이벤트가 없는 이벤트만 가져오므로, 실패한 이벤트를 재시도하는 동안 같은 대상의 이후 이벤트는 발행되지 않습니다.
+// 최대 시도 횟수를 넘겨 FAILED가 된 이벤트는 더 이상 막지 않고 이후 이벤트가 별도 표시 없이 발행되므로, 대상별 순서는 FAILED 전까지만 보장됩니다.
+func (r *Relay) Relay(ctx context.Context) (models.EventPublishResult, error) {
+	var result models.EventPublishResult
+
+	entries, err := r.eventRepo.LeaseDue(ctx, time.Now(), r.eventConfig.Lease, r.eventConfig.BatchSize)
+	if err != nil {
+		return result, err
+	}
+	result.Leased = len(entries)
+
+	for i := range entries {
+		entry := &entries[i]
+		attempts := entry.Attempts + 1
+		publishErr := r.sink.Publish(ctx, entry.Event)
+
+		switch {
+		case publishErr == nil:
+			err = r.eventRepo.MarkPublished(ctx, entry.Seq, attempts)
+			if err == nil {
+				result.Published++
+			}
+		case attempts >= r.eventConfig.MaxAttempts:
+			log.Printf("이벤트 %s(%s) 발행 실패 (%d회): %v", entry.Event.ID, entry.Event.Type, attempts, publishErr)
+			err = r.eventRepo.MarkFailed(ctx, entry.Seq, attempts, publishErr.Error())
+			if err == nil {
+				result.Failed++
+			}
+		default:
+			err = r.eventRepo.MarkRetry(ctx, entry.Seq, attempts, time.Now().Add(retryDelay(attempts)), publishErr.Error())
+			if err == nil {
+				result.Retried++
+			}
+		}
+		logRecordError(entry, err)
+	}
+
+	return result, nil
+}
+
+// logRecordError는 발행 결과 기록 실패를 로그로 남깁니다. 기록하지 못한 이벤트는 lease 만료 후 다시 발행됩니다.
+func logRecordError(entry *models.DomainEventOutbox, err error) {
+	if err != nil {
+		log.Printf("이벤트 %s 결과 기록 실패: %v", entry.Event.ID, err)
+	}
+}
+
+// retryDelay는 시도 횟수에 따른 재시도 간격(30초부터 2배씩, 최대 30분)을 반환합니다.
+func retryDelay(attempts int) time.Duration {
+	delay := 30 * time.Second
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+// Sink는 도메인 이벤트를 외부로 발행하는 대상 인터페이스입니다.
+// 같은 이벤트가 여러 번 발행될 수 있으므로 구현체는 event.ID를 멱등성 키로 전달해야 합니다.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, event models.DomainEvent) error
+}
+
+// NewSink는 설정된 이벤트 싱크를 생성합니다.
+func NewSink(ctx context.Context, cfg *config.Config, eventCfg *config.EventConfig) (Sink, error) {
+	switch strings.ToLower(eventCfg.Sink) {
+	case "sns", "sqs", "eventbridge":
+		awsCfg, err := config.LoadAWSConfig(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(eventCfg.Sink) {
+		case "sns":
+			if eventCfg.SNSTopicARN == "" {
+				return nil, fmt.Errorf("EVENT_SNS_TOPIC_ARN이 설정되지 않았습니다")
+			}
+			return NewSNSSink(sns.NewFromConfig(awsCfg), eventCfg.SNSTopicARN), nil
+		case "sqs":
+			if eventCfg.SQSQueueURL == "" {
+				return nil, fmt.Errorf("EVENT_SQS_QUEUE_URL이 설정되지 않았습니다")
+			}
+			return NewSQSSink(sqs.NewFromConfig(awsCfg), eventCfg.SQSQueueURL), nil
+		default:
+			return NewEventBridgeSink(eventbridge.NewFromConfig(awsCfg), eventCfg.EventBusName, eventCfg.EventSource), nil
+		}
+	case "file":
+		return NewFileSink(eventCfg.FilePath), nil
+	case "memory":
+		return NewMemorySink(), nil
+	case "":
+		return nil, fmt.Errorf("EVENT_SINK가 설정되지 않았습니다")
+	default:
+		return nil, fmt.Errorf("알 수 없는 이벤트 싱크입니다: %s", eventCfg.Sink)
+	}
+}
+
+// eventBody는 이벤트 전체를 메시지 본문용 JSON으로 직렬화합니다.
+func eventBody(event models.DomainEvent) (string, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("이벤트 직렬화 오류: %w", err)
+	}
+	return string(body), nil
+}
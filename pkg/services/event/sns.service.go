@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"lambda-go/pkg/models"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+// SNSSink는 도메인 이벤트를 SNS 토픽으로 발행합니다.
+type SNSSink struct {
+	client   *sns.Client
+	topicARN string
+}
+
+// NewSNSSink는 새 SNSSink 인스턴스를 생성합니다.
+func NewSNSSink(client *sns.Client, topicARN string) *SNSSink {
+	return &SNSSink{
+		client:   client,
+		topicARN: topicARN,
+	}
+}
+
+func (s *SNSSink) Name() string {
+	return "sns"
+}
+
+// Publish는 이벤트를 발행합니다. FIFO 토픽이면 대상 ID로 순서를, 이벤트 ID로 중복 제거를 보장합니다.
+func (s *SNSSink) Publish(ctx context.Context, event models.DomainEvent) error {
+	body, err := eventBody(event)
+	if err != nil {
+		return err
+	}
+
+	input := &sns.PublishInput{
+		TopicArn: aws.String(s.topicARN),
+		Message:  aws.String(body),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"eventType":      {DataType: aws.String("String"), StringValue: aws.String(string(event.Type))},
+			"idempotencyKey": {DataType: aws.String("String"), StringValue: aws.String(event.ID)},
+		},
+	}
+	if strings.HasSuffix(s.topicARN, ".fifo") {
+		input.MessageGroupId = aws.String(event.AggregateType + ":" + event.AggregateID)
+		input.MessageDeduplicationId = aws.String(event.ID)
+	}
+
+	if _, err := s.client.Publish(ctx, input); err != nil {
+		return fmt.Errorf("SNS 발행 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"lambda-go/pkg/models"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+// SQSSink는 도메인 이벤트를 SQS 큐로 전송합니다.
+type SQSSink struct {
+	client   *sqs.Client
+	queueURL string
+}
+
+// NewSQSSink는 새 SQSSink 인스턴스를 생성합니다.
+func NewSQSSink(client *sqs.Client, queueURL string) *SQSSink {
+	return &SQSSink{
+		client:   client,
+		queueURL: queueURL,
+	}
+}
+
+func (s *SQSSink) Name() string {
+	return "sqs"
+}
+
+// Publish는 이벤트를 전송합니다. FIFO 큐면 대상 ID로 순서를, 이벤트 ID로 중복 제거를 보장합니다.
+func (s *SQSSink) Publish(ctx context.Context, event models.DomainEvent) error {
+	body, err := eventBody(event)
+	if err != nil {
+		return err
+	}
+
+	input := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(s.queueURL),
+		MessageBody: aws.String(body),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"eventType":      {DataType: aws.String("String"), StringValue: aws.String(string(event.Type))},
+			"idempotencyKey": {DataType: aws.String("String"), StringValue: aws.String(event.ID)},
+		},
+	}
+	if strings.HasSuffix(s.queueURL, ".fifo") {
+		input.MessageGroupId = aws.String(event.AggregateType + ":" + event.AggregateID)
+		input.MessageDeduplicationId = aws.String(event.ID)
+	}
+
+	if _, err := s.client.SendMessage(ctx, input); err != nil {
+		return fmt.Errorf("SQS 전송 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	repository "lambda-go/pkg/repositories"
+	"lambda-go/pkg/utils"
+)
+
+// Store는 사업자등록번호 조회 결과 저장소입니다. repository.LicenseRepository가 구현합니다.
+type Store interface {
+	FindByNumbers(ctx context.Context, numbers []string) (map[string]*models.BusinessLicenseVerification, error)
+	FindByNumber(ctx context.Context, q repository.Querier, number string) (*models.BusinessLicenseVerification, error)
+	Upsert(ctx context.Context, v *models.BusinessLicenseVerification) error
+}
+
+// Checker는 사업자등록번호 형식 검증, 조회 제공자 호출, 조회 결과 저장을 묶어 처리합니다.
+// 최근 조회 결과가 TTL 안에 있으면 제공자를 다시 호출하지 않습니다.
+type Checker struct {
+	verifier    Verifier
+	licenseRepo Store
+	ttl         time.Duration
+}
+
+// NewChecker는 새 Checker 인스턴스를 생성합니다.
+func NewChecker(licenseCfg *config.LicenseConfig, verifier Verifier, licenseRepo Store) *Checker {
+	return &Checker{
+		verifier:    verifier,
+		licenseRepo: licenseRepo,
+		ttl:         licenseCfg.TTL,
+	}
+}
+
+// Check는 사업자등록번호들의 상태를 조회하여 정규화한 번호별로 반환합니다.
+// force가 true면 저장된 결과와 관계없이 제공자에게 다시 조회합니다.
+// 제공자 호출이 실패하면 에러 대신 UNKNOWN 결과를 돌려주어 승인 가드에서 막히도록 합니다.
+func (c *Checker) Check(ctx context.Context, numbers []string, force bool) (map[string]*models.BusinessLicenseVerification, error) {
+	now := time.Now()
+	result := make(map[string]*models.BusinessLicenseVerification, len(numbers))
+	var changed []*models.BusinessLicenseVerification
+
+	// 형식/검증번호 오류는 제공자에게 묻지 않고 저장하지도 않음
+	valid := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		number = utils.NormalizeBusinessNumber(number)
+		if _, ok := result[number]; ok {
+			continue
+		}
+		if !utils.IsValidBusinessNumber(number) {
+			result[number] = invalidVerification(number, now)
+			continue
+		}
+		result[number] = nil
+		valid = append(valid, number)
+	}
+
+	lookup := valid
+	if !force && len(valid) > 0 {
+		stored, err := c.licenseRepo.FindByNumbers(ctx, valid)
+		if err != nil {
+			return nil, err
+		}
+
+		lookup = lookup[:0:0]
+		for _, number := range valid {
+			if v := stored[number]; v.IsFresh(now, c.ttl) {
+				result[number] = v
+			} else {
+				lookup = append(lookup, number)
+			}
+		}
+	}
+
+	if len(lookup) > 0 {
+		verified, err := c.verifier.Verify(ctx, lookup)
+		if err != nil {
+			log.Printf("사업자등록번호 상태 조회 실패 (%s): %v", c.verifier.Name(), err)
+		}
+
+		for _, number := range lookup {
+			v := verified[number]
+			if v == nil {
+				message := "조회 결과가 없습니다"
+				if err != nil {
+					message = err.Error()
+				}
+				v = &models.BusinessLicenseVerification{
+					LicenseNumber: number,
+					Status:        models.LICENSE_UNKNOWN,
+					Provider:      c.verifier.Name(),
+					Message:       &message,
+					CheckedAt:     now,
+				}
+			}
+			result[number] = v
+			changed = append(changed, v)
+		}
+	}
+
+	for _, v := range changed {
+		if err := c.licenseRepo.Upsert(ctx, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Current는 주어진 트랜잭션 안에서 사업자등록번호의 저장된 조회 결과를 반환합니다. 제공자는 호출하지 않습니다.
+// 형식/검증번호 오류는 저장 여부와 관계없이 INVALID로, 조회한 적이 없으면 nil을 반환합니다.
+func (c *Checker) Current(ctx context.Context, q repository.Querier, number string) (*models.BusinessLicenseVerification, error) {
+	number = utils.NormalizeBusinessNumber(number)
+	if !utils.IsValidBusinessNumber(number) {
+		return invalidVerification(number, time.Now()), nil
+	}
+
+	v, err := c.licenseRepo.FindByNumber(ctx, q, number)
+	if err != nil {
+		return nil, fmt.Errorf("사업자등록번호 조회 결과 확인 실패: %w", err)
+	}
+	return v, nil
+}
+
+// invalidVerification은 형식 또는 검증번호가 잘못된 번호의 조회 결과를 만듭니다.
+func invalidVerification(number string, now time.Time) *models.BusinessLicenseVerification {
+	message := "사업자등록번호 형식 또는 검증번호가 올바르지 않습니다"
+	return &models.BusinessLicenseVerification{
+		LicenseNumber: number,
+		Status:        models.LICENSE_INVALID,
+		Provider:      "checksum",
+		Message:       &message,
+		CheckedAt:     now,
+	}
+}
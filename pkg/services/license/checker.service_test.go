@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	repository "lambda-go/pkg/repositories"
+)
+
+// memoryStore는 조회 결과를 메모리에 보관하는 테스트용 Store입니다.
+type memoryStore struct {
+	stored   map[string]*models.BusinessLicenseVerification
+	upserted []string
+}
+
+func (s *memoryStore) FindByNumbers(ctx context.Context, numbers []string) (map[string]*models.BusinessLicenseVerification, error) {
+	result := map[string]*models.BusinessLicenseVerification{}
+	for _, number := range numbers {
+		if v, ok := s.stored[number]; ok {
+			result[number] = v
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStore) FindByNumber(ctx context.Context, q repository.Querier, number string) (*models.BusinessLicenseVerification, error) {
+	return s.stored[number], nil
+}
+
+func (s *memoryStore) Upsert(ctx context.Context, v *models.BusinessLicenseVerification) error {
+	s.stored[v.LicenseNumber] = v
+	s.upserted = append(s.upserted, v.LicenseNumber)
+	return nil
+}
+
+// countingVerifier는 제공자 호출 번호를 기록하며 FakeVerifier로 응답합니다. err가 있으면 조회에 실패합니다.
+type countingVerifier struct {
+	FakeVerifier
+	calls [][]string
+	err   error
+}
+
+func (v *countingVerifier) Verify(ctx context.Context, numbers []string) (map[string]*models.BusinessLicenseVerification, error) {
+	v.calls = append(v.calls, append([]string(nil), numbers...))
+	if v.err != nil {
+		return nil, v.err
+	}
+	return v.FakeVerifier.Verify(ctx, numbers)
+}
+
+const checkerTTL = 24 * time.Hour
+
+func newTestChecker(stored ...*models.BusinessLicenseVerification) (*Checker, *memoryStore, *countingVerifier) {
+	store := &memoryStore{stored: map[string]*models.BusinessLicenseVerification{}}
+	for _, v := range stored {
+		store.stored[v.LicenseNumber] = v
+	}
+	verifier := &countingVerifier{}
+	return NewChecker(&config.LicenseConfig{TTL: checkerTTL}, verifier, store), store, verifier
+}
+
+func storedVerification(number string, status models.LicenseStatus, age time.Duration) *models.BusinessLicenseVerification {
+	return &models.BusinessLicenseVerification{
+		LicenseNumber: number,
+		Status:        status,
+		Provider:      "stored",
+		CheckedAt:     time.Now().Add(-age),
+	}
+}
+
+func TestCheckerReusesFreshResult(t *testing.T) {
+	checker, store, verifier := newTestChecker(storedVerification("2208162517", models.LICENSE_CLOSED, time.Hour))
+
+	result, err := checker.Check(context.Background(), []string{"220-81-62517"}, false)
+	if err != nil {
+		t.Fatalf("Check() 오류: %v", err)
+	}
+
+	if len(verifier.calls) != 0 {
+		t.Errorf("TTL 안의 결과가 있으면 제공자를 호출하지 않아야 합니다: %v", verifier.calls)
+	}
+	if v := result["2208162517"]; v == nil || v.Status != models.LICENSE_CLOSED || v.Provider != "stored" {
+		t.Errorf("저장된 결과를 반환해야 합니다: %+v", v)
+	}
+	if len(store.upserted) != 0 {
+		t.Errorf("재사용한 결과는 다시 저장하지 않아야 합니다: %v", store.upserted)
+	}
+}
+
+func TestCheckerRefreshesStaleOrUnknownResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		stored *models.BusinessLicenseVerification
+		force  bool
+	}{
+		{"TTL 경과", storedVerification("2208162517", models.LICENSE_CLOSED, checkerTTL+time.Minute), false},
+		{"조회 실패 결과", storedVerification("2208162517", models.LICENSE_UNKNOWN, time.Minute), false},
+		{"강제 재조회", storedVerification("2208162517", models.LICENSE_CLOSED, time.Minute), true},
+		{"조회한 적 없음", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored []*models.BusinessLicenseVerification
+			if tt.stored != nil {
+				stored = append(stored, tt.stored)
+			}
+			checker, store, verifier := newTestChecker(stored...)
+
+			result, err := checker.Check(context.Background(), []string{"2208162517"}, tt.force)
+			if err != nil {
+				t.Fatalf("Check() 오류: %v", err)
+			}
+
+			if len(verifier.calls) != 1 || len(verifier.calls[0]) != 1 || verifier.calls[0][0] != "2208162517" {
+				t.Errorf("제공자를 한 번 호출해야 합니다: %v", verifier.calls)
+			}
+			if v := result["2208162517"]; v == nil || v.Status != models.LICENSE_ACTIVE || v.Provider != "fake" {
+				t.Errorf("새로 조회한 결과를 반환해야 합니다: %+v", v)
+			}
+			if len(store.upserted) != 1 {
+				t.Errorf("새로 조회한 결과를 저장해야 합니다: %v", store.upserted)
+			}
+		})
+	}
+}
+
+func TestCheckerSkipsInvalidNumbers(t *testing.T) {
+	checker, store, verifier := newTestChecker()
+
+	result, err := checker.Check(context.Background(), []string{"123-45-67890", "2208162517", "220-81-62517"}, false)
+	if err != nil {
+		t.Fatalf("Check() 오류: %v", err)
+	}
+
+	if v := result["1234567890"]; v == nil || v.Status != models.LICENSE_INVALID {
+		t.Errorf("검증번호가 틀린 번호는 INVALID여야 합니다: %+v", v)
+	}
+	if len(verifier.calls) != 1 || len(verifier.calls[0]) != 1 {
+		t.Errorf("유효한 번호만 중복 없이 한 번 조회해야 합니다: %v", verifier.calls)
+	}
+	if _, ok := store.stored["1234567890"]; ok {
+		t.Error("INVALID 결과는 저장하지 않아야 합니다")
+	}
+}
+
+func TestCheckerVerifierFailureReturnsUnknown(t *testing.T) {
+	checker, store, verifier := newTestChecker()
+	verifier.err = errors.New("제공자 응답 없음")
+
+	result, err := checker.Check(context.Background(), []string{"2208162517"}, false)
+	if err != nil {
+		t.Fatalf("제공자 실패는 Check() 오류가 아니어야 합니다: %v", err)
+	}
+
+	v := result["2208162517"]
+	if v == nil || v.Status != models.LICENSE_UNKNOWN || v.Message == nil || *v.Message != "제공자 응답 없음" {
+		t.Fatalf("UNKNOWN과 실패 메시지를 반환해야 합니다: %+v", v)
+	}
+	if v.IsFresh(time.Now(), checkerTTL) {
+		t.Error("UNKNOWN 결과는 다음 조회에서 재사용하지 않아야 합니다")
+	}
+	if len(store.upserted) != 1 {
+		t.Errorf("UNKNOWN 결과도 저장해야 합니다: %v", store.upserted)
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+)
+
+// FakeVerifier는 외부 API 없이 미리 지정한 상태를 돌려주는 조회 제공자입니다 (로컬 개발, 테스트용).
+// 지정하지 않은 번호는 계속사업자(ACTIVE)로 응답합니다.
+type FakeVerifier struct {
+	statuses map[string]models.LicenseStatus
+}
+
+// NewFakeVerifier는 "번호:상태" 목록으로 FakeVerifier를 생성합니다.
+func NewFakeVerifier(entries []string) (*FakeVerifier, error) {
+	statuses := make(map[string]models.LicenseStatus, len(entries))
+	for _, entry := range entries {
+		number, status, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("잘못된 LICENSE_FAKE_STATUSES 항목입니다: %s", entry)
+		}
+
+		switch s := models.LicenseStatus(strings.ToUpper(strings.TrimSpace(status))); s {
+		case models.LICENSE_ACTIVE, models.LICENSE_SUSPENDED, models.LICENSE_CLOSED, models.LICENSE_UNKNOWN:
+			statuses[utils.NormalizeBusinessNumber(number)] = s
+		default:
+			return nil, fmt.Errorf("잘못된 사업자 상태입니다: %s", status)
+		}
+	}
+
+	return &FakeVerifier{statuses: statuses}, nil
+}
+
+func (v *FakeVerifier) Name() string {
+	return "fake"
+}
+
+// Verify는 지정된 상태 또는 ACTIVE를 반환합니다.
+func (v *FakeVerifier) Verify(ctx context.Context, numbers []string) (map[string]*models.BusinessLicenseVerification, error) {
+	now := time.Now()
+	result := make(map[string]*models.BusinessLicenseVerification, len(numbers))
+	for _, number := range numbers {
+		status, ok := v.statuses[number]
+		if !ok {
+			status = models.LICENSE_ACTIVE
+		}
+		result[number] = &models.BusinessLicenseVerification{
+			LicenseNumber: number,
+			Status:        status,
+			Provider:      v.Name(),
+			CheckedAt:     now,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"lambda-go/pkg/models"
+)
+
+func TestFakeVerifier(t *testing.T) {
+	verifier, err := NewFakeVerifier([]string{"220-81-62517:closed", "1248100998: SUSPENDED "})
+	if err != nil {
+		t.Fatalf("NewFakeVerifier() 오류: %v", err)
+	}
+
+	result, err := verifier.Verify(context.Background(), []string{"2208162517", "1248100998", "1234567891"})
+	if err != nil {
+		t.Fatalf("Verify() 오류: %v", err)
+	}
+
+	want := map[string]models.LicenseStatus{
+		"2208162517": models.LICENSE_CLOSED,
+		"1248100998": models.LICENSE_SUSPENDED,
+		"1234567891": models.LICENSE_ACTIVE, // 지정하지 않은 번호
+	}
+	for number, status := range want {
+		v := result[number]
+		if v == nil {
+			t.Errorf("%s 결과가 없습니다", number)
+			continue
+		}
+		if v.Status != status || v.Provider != "fake" || v.LicenseNumber != number {
+			t.Errorf("%s = %+v, want 상태 %s, 제공자 fake", number, v, status)
+		}
+	}
+}
+
+func TestNewFakeVerifierRejectsInvalidEntries(t *testing.T) {
+	for _, entry := range []string{"2208162517", "2208162517:OPEN", "2208162517:INVALID"} {
+		if _, err := NewFakeVerifier([]string{entry}); err == nil {
+			t.Errorf("NewFakeVerifier(%q)는 오류를 반환해야 합니다", entry)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+)
+
+// ntsBatchSize는 국세청 상태조회 API 한 번에 조회할 수 있는 최대 번호 수입니다.
+const ntsBatchSize = 100
+
+// 국세청 납세자 상태 코드 (b_stt_cd)
+const (
+	ntsStatusActive    = "01" // 계속사업자
+	ntsStatusSuspended = "02" // 휴업자
+	ntsStatusClosed    = "03" // 폐업자
+)
+
+// NTSVerifier는 국세청 사업자등록 상태조회 API(공공데이터포털)로 사업자 상태를 조회합니다.
+type NTSVerifier struct {
+	endpoint   string
+	serviceKey string
+	httpClient *http.Client
+}
+
+// NewNTSVerifier는 새 NTSVerifier 인스턴스를 생성합니다.
+func NewNTSVerifier(cfg *config.LicenseConfig, httpClient *http.Client) *NTSVerifier {
+	return &NTSVerifier{
+		endpoint:   cfg.NTSEndpoint,
+		serviceKey: cfg.NTSServiceKey,
+		httpClient: httpClient,
+	}
+}
+
+func (v *NTSVerifier) Name() string {
+	return "nts"
+}
+
+// ntsStatusResponse는 상태조회 API 응답입니다.
+type ntsStatusResponse struct {
+	StatusCode string `json:"status_code"`
+	Data       []struct {
+		BusinessNumber string `json:"b_no"`
+		Status         string `json:"b_stt"`
+		StatusCode     string `json:"b_stt_cd"`
+		TaxType        string `json:"tax_type"` // 미등록 번호면 안내 문구가 담김
+		EndDate        string `json:"end_dt"`   // 폐업일 (YYYYMMDD)
+	} `json:"data"`
+}
+
+// Verify는 번호를 100개씩 나누어 상태를 조회합니다.
+func (v *NTSVerifier) Verify(ctx context.Context, numbers []string) (map[string]*models.BusinessLicenseVerification, error) {
+	result := make(map[string]*models.BusinessLicenseVerification, len(numbers))
+
+	for start := 0; start < len(numbers); start += ntsBatchSize {
+		end := start + ntsBatchSize
+		if end > len(numbers) {
+			end = len(numbers)
+		}
+
+		resp, err := v.requestStatus(ctx, numbers[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		for _, item := range resp.Data {
+			verification := &models.BusinessLicenseVerification{
+				LicenseNumber: item.BusinessNumber,
+				Provider:      v.Name(),
+				CheckedAt:     now,
+			}
+
+			switch item.StatusCode {
+			case ntsStatusActive:
+				verification.Status = models.LICENSE_ACTIVE
+			case ntsStatusSuspended:
+				verification.Status = models.LICENSE_SUSPENDED
+			case ntsStatusClosed:
+				verification.Status = models.LICENSE_CLOSED
+			default:
+				verification.Status = models.LICENSE_UNKNOWN
+			}
+
+			if verification.Status == models.LICENSE_UNKNOWN {
+				if item.TaxType != "" {
+					message := item.TaxType
+					verification.Message = &message
+				}
+			} else {
+				if item.TaxType != "" {
+					taxType := item.TaxType
+					verification.TaxType = &taxType
+				}
+				if item.Status != "" {
+					message := item.Status
+					verification.Message = &message
+				}
+			}
+
+			if closedAt, err := time.Parse("20060102", item.EndDate); err == nil {
+				verification.ClosedAt = &closedAt
+			}
+
+			result[item.BusinessNumber] = verification
+		}
+	}
+
+	return result, nil
+}
+
+// requestStatus는 상태조회 API를 한 번 호출합니다.
+func (v *NTSVerifier) requestStatus(ctx context.Context, numbers []string) (*ntsStatusResponse, error) {
+	body, err := json.Marshal(map[string][]string{"b_no": numbers})
+	if err != nil {
+		return nil, fmt.Errorf("국세청 상태조회 요청 생성 실패: %w", err)
+	}
+
+	endpoint := v.endpoint + "?serviceKey=" + url.QueryEscape(v.serviceKey) + "&returnType=JSON"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("국세청 상태조회 요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	httpResp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("국세청 상태조회 요청 실패: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("국세청 상태조회 응답 오류: %d", httpResp.StatusCode)
+	}
+
+	var resp ntsStatusResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("국세청 상태조회 응답 해석 실패: %w", err)
+	}
+	if resp.StatusCode != "OK" {
+		return nil, fmt.Errorf("국세청 상태조회 실패: %s", resp.StatusCode)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+)
+
+// Verifier는 사업자등록번호 상태 조회 제공자 인터페이스입니다.
+// numbers는 형식과 검증번호를 통과한 하이픈 없는 번호이며, 결과에 없는 번호는 호출 측에서 UNKNOWN으로 처리합니다.
+type Verifier interface {
+	Name() string
+	Verify(ctx context.Context, numbers []string) (map[string]*models.BusinessLicenseVerification, error)
+}
+
+// NewVerifier는 설정된 사업자등록번호 조회 제공자를 생성합니다.
+func NewVerifier(licenseCfg *config.LicenseConfig) (Verifier, error) {
+	switch strings.ToLower(licenseCfg.Verifier) {
+	case "nts":
+		if licenseCfg.NTSServiceKey == "" {
+			return nil, fmt.Errorf("NTS_SERVICE_KEY가 설정되지 않았습니다")
+		}
+		return NewNTSVerifier(licenseCfg, &http.Client{Timeout: licenseCfg.HTTPTimeout}), nil
+	case "fake":
+		return NewFakeVerifier(licenseCfg.FakeStatuses)
+	default:
+		return nil, fmt.Errorf("알 수 없는 사업자등록번호 조회 제공자입니다: %s", licenseCfg.Verifier)
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+)
+
+// ErrNoAddress는 수신자에게 해당 채널의 수신 주소가 없을 때 반환됩니다. 재시도하지 않습니다.
+var ErrNoAddress = errors.New("수신 주소가 없습니다")
+
+// PermanentError는 재시도해도 성공할 수 없는 발송 실패입니다 (잘못된 주소, 게이트웨이의 4xx 응답 등).
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Channel은 알림 발송 채널 인터페이스입니다.
+type Channel interface {
+	Name() models.NotificationChannel
+	Send(ctx context.Context, recipient *models.NotificationRecipient, message models.NotificationMessage) error
+}
+
+// NewChannels는 설정된 채널 구현체를 생성합니다.
+func NewChannels(cfg *config.Config, notifyCfg *config.NotificationConfig) map[models.NotificationChannel]Channel {
+	httpClient := &http.Client{Timeout: notifyCfg.HTTPTimeout}
+
+	channels := map[models.NotificationChannel]Channel{}
+	for _, name := range models.ParseNotificationChannels(cfg.NotifyChannels) {
+		switch name {
+		case models.CHANNEL_EMAIL:
+			channels[name] = NewSMTPChannel(notifyCfg)
+		case models.CHANNEL_SMS:
+			channels[name] = NewSMSChannel(notifyCfg, httpClient)
+		case models.CHANNEL_WEB_PUSH:
+			channels[name] = NewWebPushChannel(notifyCfg, httpClient)
+		case models.CHANNEL_LOG:
+			channels[name] = NewLogChannel()
+		}
+	}
+	return channels
+}
+
+// gatewayError는 HTTP 게이트웨이 응답 코드를 발송 에러로 변환합니다.
+// 429와 5xx는 재시도하고, 나머지 4xx는 재시도하지 않습니다.
+func gatewayError(channel models.NotificationChannel, statusCode int) error {
+	if statusCode >= 200 && statusCode < 300 {
+		return nil
+	}
+
+	err := fmt.Errorf("%s 게이트웨이 응답 오류: %d", channel, statusCode)
+	if statusCode >= 400 && statusCode < 500 && statusCode != http.StatusTooManyRequests {
+		return &PermanentError{Err: err}
+	}
+	return err
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	repository "lambda-go/pkg/repositories"
+)
+
+// maxRetryDelay는 재시도 간격의 상한입니다.
+const maxRetryDelay = time.Hour
+
+// Dispatcher는 알림 아웃박스의 커밋된 알림을 채널로 발송합니다.
+type Dispatcher struct {
+	notifyConfig     *config.NotificationConfig
+	notificationRepo *repository.NotificationRepository
+	rejectReasonRepo *repository.RejectReasonRepository
+	channels         map[models.NotificationChannel]Channel
+}
+
+// NewDispatcher는 새 Dispatcher 인스턴스를 생성합니다.
+func NewDispatcher(notifyCfg *config.NotificationConfig, notificationRepo *repository.NotificationRepository, rejectReasonRepo *repository.RejectReasonRepository, channels map[models.NotificationChannel]Channel) *Dispatcher {
+	return &Dispatcher{
+		notifyConfig:     notifyCfg,
+		notificationRepo: notificationRepo,
+		rejectReasonRepo: rejectReasonRepo,
+		channels:         channels,
+	}
+}
+
+// Dispatch는 발송 시간이 된 알림을 한 배치 가져와 발송하고 결과를 기록합니다.
+func (d *Dispatcher) Dispatch(ctx context.Context) (models.NotificationDispatchResult, error) {
+	var result models.NotificationDispatchResult
+
+	entries, err := d.notificationRepo.LeaseDue(ctx, time.Now(), d.notifyConfig.Lease, d.notifyConfig.BatchSize)
+	if err != nil {
+		return result, err
+	}
+	result.Leased = len(entries)
+
+	for i := range entries {
+		entry := &entries[i]
+		attempts := entry.Attempts + 1
+		sendErr := d.send(ctx, entry)
+
+		var status models.NotificationStatus
+		var permanent *PermanentError
+		switch {
+		case sendErr == nil:
+			status = models.NOTIFICATION_SENT
+			err = d.notificationRepo.MarkSent(ctx, entry.ID, attempts)
+		case errors.Is(sendErr, ErrNoAddress):
+			status = models.NOTIFICATION_SKIPPED
+			err = d.notificationRepo.MarkFinished(ctx, entry.ID, status, attempts, sendErr.Error())
+		case errors.As(sendErr, &permanent) || attempts >= d.notifyConfig.MaxAttempts:
+			status = models.NOTIFICATION_FAILED
+			err = d.notificationRepo.MarkFinished(ctx, entry.ID, status, attempts, sendErr.Error())
+		default:
+			status = models.NOTIFICATION_PENDING
+			err = d.notificationRepo.MarkRetry(ctx, entry.ID, attempts, time.Now().Add(retryDelay(attempts)), sendErr.Error())
+		}
+		if err != nil {
+			// 결과 기록 실패 시 lease 만료 후 다시 발송 대상이 됨
+			log.Printf("알림 %d 결과 기록 실패: %v", entry.ID, err)
+			continue
+		}
+
+		switch status {
+		case models.NOTIFICATION_SENT:
+			result.Sent++
+		case models.NOTIFICATION_SKIPPED:
+			result.Skipped++
+		case models.NOTIFICATION_FAILED:
+			log.Printf("알림 %d 발송 실패 (%s, %d회): %v", entry.ID, entry.Channel, attempts, sendErr)
+			result.Failed++
+		default:
+			result.Retried++
+		}
+	}
+
+	return result, nil
+}
+
+// send는 알림 하나를 렌더링하여 채널로 발송합니다.
+func (d *Dispatcher) send(ctx context.Context, entry *models.NotificationOutbox) error {
+	channel, ok := d.channels[entry.Channel]
+	if !ok {
+		return &PermanentError{Err: fmt.Errorf("설정되지 않은 알림 채널입니다: %s", entry.Channel)}
+	}
+
+	var payload models.RestaurantRequestNotification
+	if err := json.Unmarshal(entry.Payload, &payload); err != nil {
+		return &PermanentError{Err: fmt.Errorf("알림 페이로드 파싱 오류: %w", err)}
+	}
+
+	recipient, err := d.notificationRepo.GetRecipient(ctx, entry.UserID, payload.RestaurantID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("%w: %v", ErrNoAddress, err)
+		}
+		return err
+	}
+
+	rejectReason, err := d.rejectReason(ctx, entry.Locale, &payload)
+	if err != nil {
+		return err
+	}
+
+	message, err := RenderMessage(entry.Template, entry.Locale, recipient, &payload, rejectReason)
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+
+	return channel.Send(ctx, recipient, message)
+}
+
+// rejectReason은 알림 언어에 맞는 거절 사유를 만듭니다.
+// 한국어는 처리 시점에 저장된 문구를 그대로 사용하고, 다른 언어는 거절 사유 코드로 다시 렌더링합니다.
+func (d *Dispatcher) rejectReason(ctx context.Context, locale models.Locale, payload *models.RestaurantRequestNotification) (string, error) {
+	if payload.Status != models.REJECTED {
+		return "", nil
+	}
+
+	if (locale == models.LOCALE_KO || len(payload.RejectReasonCodes) == 0) && payload.RejectReason != nil {
+		return *payload.RejectReason, nil
+	}
+
+	codes, err := d.rejectReasonRepo.FindByCodes(ctx, payload.RejectReasonCodes)
+	if err != nil {
+		return "", err
+	}
+	return models.RenderRejectReason(codes, payload.RejectNote, locale), nil
+}
+
+// retryDelay는 시도 횟수에 따른 재시도 간격(1분부터 2배씩, 최대 1시간)을 반환합니다.
+func retryDelay(attempts int) time.Duration {
+	delay := time.Minute
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"lambda-go/pkg/models"
+)
+
+// LogChannel은 알림을 발송하지 않고 로그로 출력합니다 (개발/테스트용).
+type LogChannel struct{}
+
+// NewLogChannel은 새 LogChannel 인스턴스를 생성합니다.
+func NewLogChannel() *LogChannel {
+	return &LogChannel{}
+}
+
+func (c *LogChannel) Name() models.NotificationChannel {
+	return models.CHANNEL_LOG
+}
+
+// Send는 메시지를 로그로 출력합니다.
+func (c *LogChannel) Send(ctx context.Context, recipient *models.NotificationRecipient, message models.NotificationMessage) error {
+	log.Printf("[알림] 수신자=%s 제목=%s\n%s", recipient.UserID, message.Subject, message.Body)
+	return nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+)
+
+// WebPushChannel은 HTTP 웹 푸시 게이트웨이로 알림을 발송합니다.
+// 구독 정보는 게이트웨이가 사용자 ID로 관리합니다.
+type WebPushChannel struct {
+	url        string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewWebPushChannel은 새 WebPushChannel 인스턴스를 생성합니다.
+func NewWebPushChannel(cfg *config.NotificationConfig, httpClient *http.Client) *WebPushChannel {
+	return &WebPushChannel{
+		url:        cfg.PushGatewayURL,
+		apiKey:     cfg.PushGatewayAPIKey,
+		httpClient: httpClient,
+	}
+}
+
+func (c *WebPushChannel) Name() models.NotificationChannel {
+	return models.CHANNEL_WEB_PUSH
+}
+
+// Send는 사용자의 구독 기기로 웹 푸시를 발송합니다.
+func (c *WebPushChannel) Send(ctx context.Context, recipient *models.NotificationRecipient, message models.NotificationMessage) error {
+	if c.url == "" {
+		return &PermanentError{Err: errors.New("웹 푸시 게이트웨이 URL이 설정되지 않았습니다")}
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"userId": recipient.UserID,
+		"title":  message.Subject,
+		"body":   message.Short,
+	})
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+
+	return postGateway(ctx, c.httpClient, c.url, c.apiKey, body, c.Name())
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+)
+
+// SMSChannel은 HTTP SMS 게이트웨이로 문자 알림을 발송합니다.
+type SMSChannel struct {
+	url        string
+	apiKey     string
+	sender     string
+	httpClient *http.Client
+}
+
+// NewSMSChannel은 새 SMSChannel 인스턴스를 생성합니다.
+func NewSMSChannel(cfg *config.NotificationConfig, httpClient *http.Client) *SMSChannel {
+	return &SMSChannel{
+		url:        cfg.SMSGatewayURL,
+		apiKey:     cfg.SMSGatewayAPIKey,
+		sender:     cfg.SMSSender,
+		httpClient: httpClient,
+	}
+}
+
+func (c *SMSChannel) Name() models.NotificationChannel {
+	return models.CHANNEL_SMS
+}
+
+// Send는 매장 대표 전화번호로 짧은 본문을 발송합니다.
+func (c *SMSChannel) Send(ctx context.Context, recipient *models.NotificationRecipient, message models.NotificationMessage) error {
+	if c.url == "" {
+		return &PermanentError{Err: errors.New("SMS 게이트웨이 URL이 설정되지 않았습니다")}
+	}
+
+	phone := normalizePhoneNumber(recipient.PhoneNumber)
+	if phone == "" {
+		return ErrNoAddress
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"from": c.sender,
+		"to":   phone,
+		"text": message.Short,
+	})
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+
+	return postGateway(ctx, c.httpClient, c.url, c.apiKey, body, c.Name())
+}
+
+// normalizePhoneNumber는 전화번호에서 숫자만 남깁니다.
+func normalizePhoneNumber(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+// postGateway는 JSON 본문을 HTTP 게이트웨이로 전송합니다.
+func postGateway(ctx context.Context, httpClient *http.Client, url, apiKey string, body []byte, channel models.NotificationChannel) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &PermanentError{Err: fmt.Errorf("%s 게이트웨이 요청 생성 실패: %w", channel, err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s 게이트웨이 요청 실패: %w", channel, err)
+	}
+	defer resp.Body.Close()
+
+	return gatewayError(channel, resp.StatusCode)
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+)
+
+// SMTPChannel은 SMTP로 이메일 알림을 발송합니다.
+// 로컬에서는 MailHog 같은 SMTP 대체 서버(기본 localhost:1025)로 확인할 수 있습니다.
+type SMTPChannel struct {
+	addr string
+	host string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPChannel은 새 SMTPChannel 인스턴스를 생성합니다.
+func NewSMTPChannel(cfg *config.NotificationConfig) *SMTPChannel {
+	channel := &SMTPChannel{
+		addr: net.JoinHostPort(cfg.SMTPHost, cfg.SMTPPort),
+		host: cfg.SMTPHost,
+		from: cfg.SMTPFrom,
+	}
+	if cfg.SMTPUsername != "" {
+		channel.auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+	return channel
+}
+
+func (c *SMTPChannel) Name() models.NotificationChannel {
+	return models.CHANNEL_EMAIL
+}
+
+// Send는 수신자 이메일로 메시지를 발송합니다.
+func (c *SMTPChannel) Send(ctx context.Context, recipient *models.NotificationRecipient, message models.NotificationMessage) error {
+	if recipient.Email == "" {
+		return ErrNoAddress
+	}
+	to, err := mail.ParseAddress(recipient.Email)
+	if err != nil {
+		return &PermanentError{Err: fmt.Errorf("잘못된 이메일 주소: %w", err)}
+	}
+
+	msg := buildMailMessage(c.from, (&mail.Address{Name: recipient.Name, Address: to.Address}).String(), message)
+
+	if err := smtp.SendMail(c.addr, c.auth, c.from, []string{to.Address}, msg); err != nil {
+		return fmt.Errorf("이메일 발송 실패: %w", err)
+	}
+	return nil
+}
+
+// buildMailMessage는 UTF-8 텍스트 이메일 메시지를 구성합니다.
+func buildMailMessage(from, to string, message models.NotificationMessage) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	// 본문은 76자 단위로 줄바꿈한 base64로 인코딩
+	encoded := base64.StdEncoding.EncodeToString([]byte(message.Body))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+
+	return buf.Bytes()
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"lambda-go/pkg/models"
+)
+
+// messageTemplate은 알림 종류/언어별 메시지 템플릿입니다.
+type messageTemplate struct {
+	Subject string
+	Body    string
+	Short   string
+}
+
+// messageData는 메시지 템플릿에 전달되는 값입니다.
+type messageData struct {
+	Name           string
+	RestaurantName string
+	RequestID      int
+	RejectReason   string
+}
+
+var messageTemplates = map[models.NotificationTemplate]map[models.Locale]messageTemplate{
+	models.TEMPLATE_REQUEST_APPROVED: {
+		models.LOCALE_KO: {
+			Subject: "[매장 등록] {{.RestaurantName}} 등록 요청이 승인되었습니다",
+			Body: `{{.Name}}님, 안녕하세요.
+
+요청하신 {{.RestaurantName}}의 등록 요청(요청 번호 {{.RequestID}})이 승인되었습니다.
+사장님 페이지에서 매장 정보를 확인하고 영업을 시작해 주세요.`,
+			Short: "{{.RestaurantName}} 등록 요청이 승인되었습니다. 사장님 페이지에서 영업을 시작해 주세요.",
+		},
+		models.LOCALE_EN: {
+			Subject: "[Restaurant registration] {{.RestaurantName}} has been approved",
+			Body: `Hello {{.Name}},
+
+Your registration request for {{.RestaurantName}} (request #{{.RequestID}}) has been approved.
+Please review your restaurant details on the owner page and open for business.`,
+			Short: "Your registration request for {{.RestaurantName}} has been approved.",
+		},
+	},
+	models.TEMPLATE_REQUEST_REJECTED: {
+		models.LOCALE_KO: {
+			Subject: "[매장 등록] {{.RestaurantName}} 등록 요청이 거절되었습니다",
+			Body: `{{.Name}}님, 안녕하세요.
+
+요청하신 {{.RestaurantName}}의 등록 요청(요청 번호 {{.RequestID}})이 거절되었습니다.
+
+거절 사유:
+{{.RejectReason}}
+
+사유를 확인하신 뒤 내용을 보완해 다시 요청해 주세요.`,
+			Short: "{{.RestaurantName}} 등록 요청이 거절되었습니다. 사장님 페이지에서 거절 사유를 확인해 주세요.",
+		},
+		models.LOCALE_EN: {
+			Subject: "[Restaurant registration] {{.RestaurantName}} was not approved",
+			Body: `Hello {{.Name}},
+
+Your registration request for {{.RestaurantName}} (request #{{.RequestID}}) was not approved.
+
+Reason:
+{{.RejectReason}}
+
+Please review the reason, update your request and submit it again.`,
+			Short: "Your registration request for {{.RestaurantName}} was not approved. Please check the reason on the owner page.",
+		},
+	},
+}
+
+// RenderMessage는 알림 종류와 언어에 맞는 메시지를 렌더링합니다. 해당 언어 템플릿이 없으면 한국어를 사용합니다.
+func RenderMessage(tmpl models.NotificationTemplate, locale models.Locale, recipient *models.NotificationRecipient, payload *models.RestaurantRequestNotification, rejectReason string) (models.NotificationMessage, error) {
+	byLocale, ok := messageTemplates[tmpl]
+	if !ok {
+		return models.NotificationMessage{}, fmt.Errorf("알 수 없는 알림 템플릿: %s", tmpl)
+	}
+	mt, ok := byLocale[locale]
+	if !ok {
+		locale = models.LOCALE_KO
+		mt = byLocale[locale]
+	}
+
+	data := messageData{
+		Name:           recipient.Name,
+		RestaurantName: recipient.RestaurantName,
+		RequestID:      payload.RequestID,
+		RejectReason:   strings.TrimSpace(rejectReason),
+	}
+	if data.Name == "" {
+		data.Name = map[models.Locale]string{models.LOCALE_KO: "사장", models.LOCALE_EN: "there"}[locale]
+	}
+	if data.RestaurantName == "" {
+		data.RestaurantName = map[models.Locale]string{models.LOCALE_KO: "매장", models.LOCALE_EN: "your restaurant"}[locale]
+	}
+
+	var message models.NotificationMessage
+	for _, part := range []struct {
+		text string
+		dst  *string
+	}{
+		{mt.Subject, &message.Subject},
+		{mt.Body, &message.Body},
+		{mt.Short, &message.Short},
+	} {
+		t, err := template.New(string(tmpl)).Parse(part.text)
+		if err != nil {
+			return message, fmt.Errorf("알림 템플릿 파싱 오류: %w", err)
+		}
+
+		var sb strings.Builder
+		if err := t.Execute(&sb, data); err != nil {
+			return message, fmt.Errorf("알림 템플릿 렌더링 오류: %w", err)
+		}
+		*part.dst = sb.String()
+	}
+
+	return message, nil
+}
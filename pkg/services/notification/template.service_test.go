@@ -0,0 +1,118 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"lambda-go/pkg/models"
+)
+
+func TestRenderMessage(t *testing.T) {
+	recipient := &models.NotificationRecipient{UserID: "user1", Name: "김사장", RestaurantName: "맛있는 식당"}
+	payload := &models.RestaurantRequestNotification{RequestID: 42}
+
+	tests := []struct {
+		name         string
+		template     models.NotificationTemplate
+		locale       models.Locale
+		recipient    *models.NotificationRecipient
+		rejectReason string
+		subject      string
+		body         []string
+		short        string
+	}{
+		{
+			name:      "승인 한국어",
+			template:  models.TEMPLATE_REQUEST_APPROVED,
+			locale:    models.LOCALE_KO,
+			recipient: recipient,
+			subject:   "[매장 등록] 맛있는 식당 등록 요청이 승인되었습니다",
+			body:      []string{"김사장님, 안녕하세요.", "(요청 번호 42)"},
+			short:     "맛있는 식당 등록 요청이 승인되었습니다. 사장님 페이지에서 영업을 시작해 주세요.",
+		},
+		{
+			name:      "승인 영어",
+			template:  models.TEMPLATE_REQUEST_APPROVED,
+			locale:    models.LOCALE_EN,
+			recipient: recipient,
+			subject:   "[Restaurant registration] 맛있는 식당 has been approved",
+			body:      []string{"Hello 김사장,", "(request #42)"},
+			short:     "Your registration request for 맛있는 식당 has been approved.",
+		},
+		{
+			name:         "거절 사유 앞뒤 공백 제거",
+			template:     models.TEMPLATE_REQUEST_REJECTED,
+			locale:       models.LOCALE_KO,
+			recipient:    recipient,
+			rejectReason: "  사업자등록증 이미지가 흐립니다\n",
+			subject:      "[매장 등록] 맛있는 식당 등록 요청이 거절되었습니다",
+			body:         []string{"거절 사유:\n사업자등록증 이미지가 흐립니다\n\n사유를 확인하신 뒤"},
+			short:        "맛있는 식당 등록 요청이 거절되었습니다. 사장님 페이지에서 거절 사유를 확인해 주세요.",
+		},
+		{
+			name:         "지원하지 않는 언어는 한국어",
+			template:     models.TEMPLATE_REQUEST_REJECTED,
+			locale:       models.Locale("ja"),
+			recipient:    recipient,
+			rejectReason: "주소 불일치",
+			subject:      "[매장 등록] 맛있는 식당 등록 요청이 거절되었습니다",
+			body:         []string{"거절 사유:\n주소 불일치"},
+			short:        "맛있는 식당 등록 요청이 거절되었습니다. 사장님 페이지에서 거절 사유를 확인해 주세요.",
+		},
+		{
+			name:      "이름과 매장명이 없으면 언어별 기본값",
+			template:  models.TEMPLATE_REQUEST_APPROVED,
+			locale:    models.LOCALE_EN,
+			recipient: &models.NotificationRecipient{UserID: "user1"},
+			subject:   "[Restaurant registration] your restaurant has been approved",
+			body:      []string{"Hello there,"},
+			short:     "Your registration request for your restaurant has been approved.",
+		},
+		{
+			name:      "이름과 매장명이 없으면 한국어 기본값",
+			template:  models.TEMPLATE_REQUEST_APPROVED,
+			locale:    models.Locale("ja"),
+			recipient: &models.NotificationRecipient{UserID: "user1"},
+			subject:   "[매장 등록] 매장 등록 요청이 승인되었습니다",
+			body:      []string{"사장님, 안녕하세요."},
+			short:     "매장 등록 요청이 승인되었습니다. 사장님 페이지에서 영업을 시작해 주세요.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := RenderMessage(tt.template, tt.locale, tt.recipient, payload, tt.rejectReason)
+			if err != nil {
+				t.Fatalf("RenderMessage() 오류: %v", err)
+			}
+			if message.Subject != tt.subject {
+				t.Errorf("Subject = %q, want %q", message.Subject, tt.subject)
+			}
+			for _, want := range tt.body {
+				if !strings.Contains(message.Body, want) {
+					t.Errorf("Body에 %q가 없습니다:\n%s", want, message.Body)
+				}
+			}
+			if message.Short != tt.short {
+				t.Errorf("Short = %q, want %q", message.Short, tt.short)
+			}
+		})
+	}
+}
+
+func TestRenderMessageUnknownTemplate(t *testing.T) {
+	_, err := RenderMessage(models.NotificationTemplate("UNKNOWN"), models.LOCALE_KO, &models.NotificationRecipient{}, &models.RestaurantRequestNotification{}, "")
+	if err == nil {
+		t.Fatal("알 수 없는 템플릿은 오류를 반환해야 합니다")
+	}
+}
+
+func TestMessageTemplatesCoverLocales(t *testing.T) {
+	for tmpl, byLocale := range messageTemplates {
+		for _, locale := range []models.Locale{models.LOCALE_KO, models.LOCALE_EN} {
+			if _, ok := byLocale[locale]; !ok {
+				t.Errorf("%s 템플릿에 %s 메시지가 없습니다", tmpl, locale)
+			}
+		}
+	}
+}
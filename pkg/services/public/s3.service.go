@@ -1,8 +1,13 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
+	"strings"
 	"time"
 
 	config "lambda-go/pkg/configs"
@@ -11,73 +16,160 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+var (
+	// ErrObjectNotFound는 S3 객체가 없을 때 반환됩니다.
+	ErrObjectNotFound = errors.New("S3 객체를 찾을 수 없습니다")
+	// ErrObjectTooLarge는 S3 객체가 허용 크기를 넘을 때 반환됩니다.
+	ErrObjectTooLarge = errors.New("S3 객체가 최대 크기를 넘습니다")
 )
 
 // S3Service는 S3 관련 서비스를 제공합니다.
 type S3Service struct {
 	config        *config.Config
+	uploadCfg     *config.UploadConfig
 	s3Client      *s3.Client
 	presignClient *s3.PresignClient
 }
 
 // NewS3Service는 새 S3Service 인스턴스를 생성합니다.
-func NewS3Service(cfg *config.Config, s3Client *s3.Client, presignClient *s3.PresignClient) *S3Service {
+func NewS3Service(cfg *config.Config, uploadCfg *config.UploadConfig, s3Client *s3.Client, presignClient *s3.PresignClient) *S3Service {
 	return &S3Service{
 		config:        cfg,
+		uploadCfg:     uploadCfg,
 		s3Client:      s3Client,
 		presignClient: presignClient,
 	}
 }
 
-// ValidateAndPreprocessRequest는 요청을 검증하고 기본값을 설정합니다.
-func (s *S3Service) ValidateAndPreprocessRequest(req *models.PresignedURLRequest) error {
+// ValidateAndPreprocessRequest는 발급 정책에 따라 요청을 검증하고 기본값을 설정합니다.
+// 버킷은 허용 목록에 있어야 하고, GET 키는 역할별 읽기 접두사 아래여야 하며, 업로드(PUT/POST) 키는 역할별 업로드 접두사 아래에 서버가 생성합니다.
+func (s *S3Service) ValidateAndPreprocessRequest(req *models.PresignedURLRequest, userID string, role models.Role) error {
+	policy, ok := models.UploadKeyPolicies[role]
+	if !ok || !utils.IsSafeUserID(userID) {
+		return utils.Forbidden("presigned URL을 발급할 권한이 없습니다")
+	}
+
 	// 버킷 검증
 	if req.Bucket == "" {
 		req.Bucket = s.config.DefaultBucket
 		if req.Bucket == "" {
-			return fmt.Errorf("bucket이 필요합니다")
+			return utils.BadRequest("bucket이 필요합니다")
 		}
 	}
-
-	// 키 검증
-	if req.Key == "" {
-		return fmt.Errorf("key가 필요합니다")
+	if !s.uploadCfg.IsAllowedBucket(req.Bucket) {
+		return utils.Forbidden("허용되지 않은 버킷입니다")
 	}
 
 	// Method 기본값 설정
 	if req.Method == "" {
 		req.Method = "GET"
-	} else if req.Method != "GET" && req.Method != "PUT" {
-		return fmt.Errorf("지원하지 않는 HTTP 메서드입니다. GET 또는 PUT만 지원합니다")
+	} else if req.Method != "GET" && !isUploadMethod(req.Method) {
+		return utils.BadRequest("지원하지 않는 HTTP 메서드입니다. GET, PUT, POST만 지원합니다")
 	}
 
-	// Duration 기본값 설정
+	// 키 검증 (GET) 또는 생성 (PUT)
+	switch req.Method {
+	case "GET":
+		if req.Key == "" {
+			return utils.BadRequest("key가 필요합니다")
+		}
+		if !utils.IsSafeObjectKey(req.Key) {
+			return utils.BadRequest("허용되지 않는 key 형식입니다")
+		}
+		if !hasAnyPrefix(req.Key, policy.ReadPrefixes(userID)) {
+			return utils.Forbidden("해당 key의 URL을 발급할 권한이 없습니다")
+		}
+
+	case "PUT", "POST":
+		if req.Key != "" {
+			return utils.BadRequest("업로드 key는 서버에서 생성합니다. key 대신 fileName을 보내주세요")
+		}
+
+		purpose, ok := models.UploadPurposePolicies[req.Purpose]
+		if !ok {
+			return utils.BadRequest("purpose는 LICENSE_IMAGE, MENU_IMAGE, RESTAURANT_IMAGE 중 하나여야 합니다")
+		}
+
+		// Content-Type은 파일 확장자와 일치해야 하고 용도별 허용 목록에 있어야 함
+		ext := strings.ToLower(utils.GetFileExtension(req.FileName))
+		if ext == "" {
+			return utils.BadRequest("확장자가 있는 fileName이 필요합니다")
+		}
+		contentType := utils.GetMimeTypeFromExtension(ext)
+		if req.ContentType == "" {
+			req.ContentType = contentType
+		} else if req.ContentType != contentType {
+			return utils.BadRequest(fmt.Sprintf("contentType %s가 파일 확장자 %s와 일치하지 않습니다", req.ContentType, ext))
+		}
+		if !purpose.Allows(req.ContentType) {
+			return utils.BadRequest(fmt.Sprintf("%s 용도로 업로드할 수 없는 파일 형식입니다 (허용: %s)", req.Purpose, strings.Join(purpose.ContentTypes, ", ")))
+		}
+
+		key, err := newUploadKey(policy.WritePrefix(userID)+purpose.Segment+"/", ext)
+		if err != nil {
+			return err
+		}
+		req.Key = key
+
+		// 최대 파일 크기는 용도별 최대값과 DEFAULT_MAX_FILE_SIZE 중 작은 값을 넘을 수 없으며, 넘거나 생략하면 그 값으로 설정
+		maxFileSize := purpose.MaxFileSize
+		if maxFileSize <= 0 || maxFileSize > s.config.DefaultMaxFileSize {
+			maxFileSize = s.config.DefaultMaxFileSize
+		}
+		if req.MaxFileSize <= 0 || req.MaxFileSize > maxFileSize {
+			req.MaxFileSize = maxFileSize
+		}
+	}
+
+	// Duration 기본값 및 최대값 설정
+	maxDuration := int64(s.uploadCfg.MaxURLExpiry / time.Second)
 	if req.Duration <= 0 {
 		req.Duration = 3600 // 1시간 기본값
 	}
+	if req.Duration > maxDuration {
+		req.Duration = maxDuration
+	}
 
-	// ContentType 기본값 설정 (PUT 요청시)
-	if req.Method == "PUT" && req.ContentType == "" {
-		// 파일 확장자로부터 Content-Type 유추
-		if ext := utils.GetFileExtension(req.Key); ext != "" {
-			req.ContentType = utils.GetMimeTypeFromExtension(ext)
-		} else {
-			req.ContentType = "application/octet-stream"
-		}
+	return nil
+}
+
+// isUploadMethod는 업로드용 presigned 요청인지 확인합니다.
+// PUT은 크기 제한을 강제할 수 없으므로 제한이 필요하면 POST를 사용해야 합니다.
+func isUploadMethod(method string) bool {
+	return method == "PUT" || method == "POST"
+}
+
+// newUploadKey는 업로드 접두사 아래에 추측할 수 없는 키를 생성합니다.
+func newUploadKey(prefix, ext string) (string, error) {
+	id, err := utils.NewUUID()
+	if err != nil {
+		return "", utils.InternalServerError("업로드 key 생성 실패", err)
 	}
 
-	// 최대 파일 크기 기본값 설정 (PUT 요청시)
-	if req.Method == "PUT" && req.MaxFileSize <= 0 {
-		req.MaxFileSize = s.config.DefaultMaxFileSize
+	if len(ext) > 10 || strings.IndexFunc(ext, func(r rune) bool { return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9') }) >= 0 {
+		return "", utils.BadRequest("허용되지 않는 파일 확장자입니다")
 	}
+	return prefix + id + "." + ext, nil
+}
 
-	return nil
+// hasAnyPrefix는 key가 접두사 중 하나로 시작하는지 확인합니다.
+func hasAnyPrefix(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 // GeneratePresignedURL은 주어진 요청에 대한 Presigned URL을 생성합니다.
 func (s *S3Service) GeneratePresignedURL(ctx context.Context, req *models.PresignedURLRequest) (*models.PresignedURLResponse, error) {
 	var presignedURL string
-	expiresAt := time.Now().Add(time.Duration(req.Duration) * time.Second).Unix()
+	var fields map[string]string
+	expiresAt := time.Now().Add(time.Duration(req.Duration) * time.Second)
 
 	switch req.Method {
 	case "GET":
@@ -110,19 +202,113 @@ func (s *S3Service) GeneratePresignedURL(ctx context.Context, req *models.Presig
 			return nil, fmt.Errorf("presigned URL 생성 실패: %w", err)
 		}
 		presignedURL = presignReq.URL
+
+	case "POST":
+		var err error
+		presignedURL, fields, err = s.presignPost(ctx, req, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("presigned POST 생성 실패: %w", err)
+		}
 	}
 
 	// 응답 생성
 	resp := &models.PresignedURLResponse{
 		URL:       presignedURL,
-		ExpiresAt: expiresAt,
+		Bucket:    req.Bucket,
+		Key:       req.Key,
+		Method:    req.Method,
+		Fields:    fields,
+		ExpiresAt: expiresAt.Unix(),
 	}
 
-	// PUT 요청일 경우 추가 정보 제공
-	if req.Method == "PUT" {
+	// 업로드 요청일 경우 추가 정보 제공
+	if isUploadMethod(req.Method) {
 		resp.ContentType = req.ContentType
 		resp.MaxFileSize = req.MaxFileSize
 	}
 
 	return resp, nil
 }
+
+// PutObject는 서버에서 만든 파일을 S3에 업로드합니다.
+// fileName을 지정하면 다운로드 시 해당 이름으로 저장되도록 Content-Disposition을 설정합니다.
+func (s *S3Service) PutObject(ctx context.Context, bucket, key string, body []byte, contentType, fileName string) error {
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String(contentType),
+	}
+	if fileName != "" {
+		input.ContentDisposition = aws.String(contentDisposition(fileName))
+	}
+
+	if _, err := s.s3Client.PutObject(ctx, input); err != nil {
+		return fmt.Errorf("S3 업로드 실패: %w", err)
+	}
+	return nil
+}
+
+// contentDisposition은 다운로드 시 fileName으로 저장되도록 하는 Content-Disposition 값입니다.
+func contentDisposition(fileName string) string {
+	return fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(fileName))
+}
+
+// GetObject는 S3 객체를 읽습니다. 객체가 maxBytes보다 크면 끝까지 읽지 않고 오류를 반환합니다.
+func (s *S3Service) GetObject(ctx context.Context, bucket, key string, maxBytes int64) ([]byte, error) {
+	output, err := s.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, fmt.Errorf("%s: %w", key, ErrObjectNotFound)
+		}
+		return nil, fmt.Errorf("S3 객체 조회 실패: %w", err)
+	}
+	defer output.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(output.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("S3 객체 읽기 실패: %w", err)
+	}
+	if int64(len(body)) > maxBytes {
+		return nil, fmt.Errorf("%d바이트 초과: %w", maxBytes, ErrObjectTooLarge)
+	}
+	return body, nil
+}
+
+// HeadObject는 S3 객체의 크기, ETag, Content-Type을 조회합니다.
+func (s *S3Service) HeadObject(ctx context.Context, bucket, key string) (*models.S3ObjectInfo, error) {
+	output, err := s.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &notFound) || errors.As(err, &noSuchKey) {
+			return nil, fmt.Errorf("%s: %w", key, ErrObjectNotFound)
+		}
+		return nil, fmt.Errorf("S3 객체 메타데이터 조회 실패: %w", err)
+	}
+
+	return &models.S3ObjectInfo{
+		Size:        aws.ToInt64(output.ContentLength),
+		ETag:        strings.Trim(aws.ToString(output.ETag), `"`),
+		ContentType: aws.ToString(output.ContentType),
+	}, nil
+}
+
+// DeleteObject는 S3 객체를 삭제합니다. 이미 없는 객체도 성공으로 처리합니다.
+func (s *S3Service) DeleteObject(ctx context.Context, bucket, key string) error {
+	_, err := s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("S3 객체 삭제 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+)
+
+const testBucket = "my-bucket"
+
+// newTestS3Service는 요청 검증에 필요한 설정만 가진 S3Service를 생성합니다.
+func newTestS3Service(defaultMaxFileSize int64) *S3Service {
+	return NewS3Service(
+		&config.Config{DefaultBucket: testBucket, DefaultMaxFileSize: defaultMaxFileSize},
+		&config.UploadConfig{AllowedBuckets: []string{testBucket}, MaxURLExpiry: time.Hour},
+		nil, nil,
+	)
+}
+
+func TestValidateAndPreprocessRequestMaxFileSize(t *testing.T) {
+	const mib = 1024 * 1024
+	tests := []struct {
+		name               string
+		defaultMaxFileSize int64
+		purpose            models.UploadPurpose
+		requested          int64
+		want               int64
+	}{
+		{"생략하면 용도별 최대값", 10 * mib, models.UPLOAD_MENU_IMAGE, 0, 5 * mib},
+		{"최대값 이하는 그대로", 10 * mib, models.UPLOAD_MENU_IMAGE, mib, mib},
+		{"용도별 최대값을 넘으면 줄임", 10 * mib, models.UPLOAD_MENU_IMAGE, 5000000000, 5 * mib},
+		{"DEFAULT_MAX_FILE_SIZE가 더 작으면 그 값으로 줄임", 2 * mib, models.UPLOAD_LICENSE_IMAGE, 5000000000, 2 * mib},
+		{"음수는 최대값", 10 * mib, models.UPLOAD_LICENSE_IMAGE, -1, 10 * mib},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &models.PresignedURLRequest{
+				FileName:    "photo.png",
+				Purpose:     tt.purpose,
+				Method:      "POST",
+				MaxFileSize: tt.requested,
+			}
+			if err := newTestS3Service(tt.defaultMaxFileSize).ValidateAndPreprocessRequest(req, "u1", models.OWNER); err != nil {
+				t.Fatalf("ValidateAndPreprocessRequest() error = %v", err)
+			}
+			if req.MaxFileSize != tt.want {
+				t.Errorf("MaxFileSize = %d, want %d", req.MaxFileSize, tt.want)
+			}
+		})
+	}
+}
+
+// statusOf는 에러의 HTTP 상태 코드를 반환합니다. 에러가 없으면 200입니다.
+func statusOf(t *testing.T, err error) int {
+	t.Helper()
+	if err == nil {
+		return http.StatusOK
+	}
+	var appErr *utils.AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("AppError가 아닌 에러: %v", err)
+	}
+	return appErr.StatusCode
+}
+
+func TestValidateAndPreprocessRequestPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		role   models.Role
+		userID string
+		req    models.PresignedURLRequest
+		want   int
+	}{
+		{"점주 본인 키 GET", models.OWNER, "u1", models.PresignedURLRequest{Key: "restaurants/u1/menu/a.png"}, http.StatusOK},
+		{"점주가 다른 점주 키 GET", models.OWNER, "u1", models.PresignedURLRequest{Key: "restaurants/u2/menu/a.png"}, http.StatusForbidden},
+		{"점주가 ID 접두사가 겹치는 다른 점주 키 GET", models.OWNER, "u1", models.PresignedURLRequest{Key: "restaurants/u10/menu/a.png"}, http.StatusForbidden},
+		{"고객이 점주 키 GET", models.CUSTOMER, "u1", models.PresignedURLRequest{Key: "restaurants/u1/menu/a.png"}, http.StatusForbidden},
+		{"관리자는 모든 키 GET", models.ADMIN, "a1", models.PresignedURLRequest{Key: "restaurants/u2/menu/a.png"}, http.StatusOK},
+		{"GET key 없음", models.OWNER, "u1", models.PresignedURLRequest{}, http.StatusBadRequest},
+		{"GET 상위 경로 구간", models.OWNER, "u1", models.PresignedURLRequest{Key: "restaurants/u1/../u2/a.png"}, http.StatusBadRequest},
+		{"GET 선행 슬래시", models.ADMIN, "a1", models.PresignedURLRequest{Key: "/restaurants/u1/a.png"}, http.StatusBadRequest},
+		{"GET 빈 중간 구간", models.OWNER, "u1", models.PresignedURLRequest{Key: "restaurants/u1//a.png"}, http.StatusBadRequest},
+		{"허용 목록에 없는 버킷", models.ADMIN, "a1", models.PresignedURLRequest{Bucket: "other-bucket", Key: "a.png"}, http.StatusForbidden},
+		{"정책이 없는 역할", models.Role("GUEST"), "u1", models.PresignedURLRequest{Key: "users/u1/a.png"}, http.StatusForbidden},
+		{"슬래시가 들어간 사용자 ID", models.OWNER, "u1/../u2", models.PresignedURLRequest{Key: "restaurants/u2/a.png"}, http.StatusForbidden},
+		{"지원하지 않는 메서드", models.OWNER, "u1", models.PresignedURLRequest{Method: "DELETE", Key: "restaurants/u1/a.png"}, http.StatusBadRequest},
+		{"PUT에 클라이언트 key", models.OWNER, "u1", models.PresignedURLRequest{Method: "PUT", Key: "restaurants/u1/menu/a.png", FileName: "a.png", Purpose: models.UPLOAD_MENU_IMAGE}, http.StatusBadRequest},
+		{"POST에 클라이언트 key", models.OWNER, "u1", models.PresignedURLRequest{Method: "POST", Key: "restaurants/u1/menu/a.png", FileName: "a.png", Purpose: models.UPLOAD_MENU_IMAGE}, http.StatusBadRequest},
+		{"업로드 purpose 없음", models.OWNER, "u1", models.PresignedURLRequest{Method: "PUT", FileName: "a.png"}, http.StatusBadRequest},
+		{"업로드 확장자 없음", models.OWNER, "u1", models.PresignedURLRequest{Method: "PUT", FileName: "a", Purpose: models.UPLOAD_MENU_IMAGE}, http.StatusBadRequest},
+		{"contentType과 확장자 불일치", models.OWNER, "u1", models.PresignedURLRequest{Method: "PUT", FileName: "a.png", ContentType: "image/jpeg", Purpose: models.UPLOAD_MENU_IMAGE}, http.StatusBadRequest},
+		{"용도에 허용되지 않는 형식", models.OWNER, "u1", models.PresignedURLRequest{Method: "PUT", FileName: "a.pdf", Purpose: models.UPLOAD_MENU_IMAGE}, http.StatusBadRequest},
+		{"svg 업로드", models.OWNER, "u1", models.PresignedURLRequest{Method: "POST", FileName: "a.svg", Purpose: models.UPLOAD_RESTAURANT_IMAGE}, http.StatusBadRequest},
+		{"사업자등록증 pdf 업로드", models.OWNER, "u1", models.PresignedURLRequest{Method: "POST", FileName: "a.pdf", Purpose: models.UPLOAD_LICENSE_IMAGE}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			err := newTestS3Service(10*1024*1024).ValidateAndPreprocessRequest(&req, tt.userID, tt.role)
+			if got := statusOf(t, err); got != tt.want {
+				t.Errorf("상태 코드 = %d, want %d (err = %v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestValidateAndPreprocessRequestGeneratesUploadKey(t *testing.T) {
+	req := &models.PresignedURLRequest{Method: "PUT", FileName: "Photo.PNG", Purpose: models.UPLOAD_MENU_IMAGE, Duration: 86400}
+	if err := newTestS3Service(10*1024*1024).ValidateAndPreprocessRequest(req, "u1", models.OWNER); err != nil {
+		t.Fatalf("ValidateAndPreprocessRequest() error = %v", err)
+	}
+
+	if req.Bucket != testBucket {
+		t.Errorf("Bucket = %q, want 기본 버킷 %q", req.Bucket, testBucket)
+	}
+	if !strings.HasPrefix(req.Key, "restaurants/u1/menu/") || !strings.HasSuffix(req.Key, ".png") {
+		t.Errorf("Key = %q, want restaurants/u1/menu/{uuid}.png", req.Key)
+	}
+	if purpose, ok := models.ParseUploadKey(req.Key); !ok || purpose != models.UPLOAD_MENU_IMAGE {
+		t.Errorf("ParseUploadKey(%q) = (%q, %v)", req.Key, purpose, ok)
+	}
+	if req.ContentType != "image/png" {
+		t.Errorf("ContentType = %q, want image/png", req.ContentType)
+	}
+	if req.Duration != 3600 {
+		t.Errorf("Duration = %d, want 최대값 3600", req.Duration)
+	}
+}
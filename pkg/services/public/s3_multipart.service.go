@@ -0,0 +1,115 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// multipartPartSize는 멀티파트 업로드 파트 크기입니다. S3는 마지막 파트를 제외하고 5MiB 이상을 요구합니다.
+const multipartPartSize = 5 << 20
+
+// MultipartWriter는 쓰는 내용을 파트 크기만큼 모아 S3 멀티파트 업로드로 올리는 io.Writer입니다.
+// 파일 전체를 메모리에 두지 않고 파트 하나 크기의 버퍼만 사용합니다.
+// Close로 업로드를 완료하고, 중간에 실패하면 Abort로 올린 파트를 정리해야 합니다.
+type MultipartWriter struct {
+	ctx      context.Context
+	client   *s3.Client
+	bucket   string
+	key      string
+	uploadID string
+	buf      bytes.Buffer
+	parts    []types.CompletedPart
+}
+
+// NewMultipartWriter는 bucket/key에 멀티파트 업로드를 시작합니다.
+// fileName을 지정하면 다운로드 시 해당 이름으로 저장되도록 Content-Disposition을 설정합니다.
+func (s *S3Service) NewMultipartWriter(ctx context.Context, bucket, key, contentType, fileName string) (*MultipartWriter, error) {
+	input := &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	}
+	if fileName != "" {
+		input.ContentDisposition = aws.String(contentDisposition(fileName))
+	}
+
+	output, err := s.s3Client.CreateMultipartUpload(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("S3 멀티파트 업로드 시작 실패: %w", err)
+	}
+
+	return &MultipartWriter{
+		ctx:      ctx,
+		client:   s.s3Client,
+		bucket:   bucket,
+		key:      key,
+		uploadID: aws.ToString(output.UploadId),
+	}, nil
+}
+
+// Write는 p를 버퍼에 모으고, 파트 크기가 찰 때마다 파트를 업로드합니다.
+func (w *MultipartWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for w.buf.Len() >= multipartPartSize {
+		if err := w.uploadPart(w.buf.Next(multipartPartSize)); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Close는 남은 내용을 마지막 파트로 올리고 업로드를 완료합니다.
+func (w *MultipartWriter) Close() error {
+	if w.buf.Len() > 0 || len(w.parts) == 0 {
+		if err := w.uploadPart(w.buf.Next(w.buf.Len())); err != nil {
+			return err
+		}
+	}
+
+	_, err := w.client.CompleteMultipartUpload(w.ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(w.bucket),
+		Key:             aws.String(w.key),
+		UploadId:        aws.String(w.uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: w.parts},
+	})
+	if err != nil {
+		return fmt.Errorf("S3 멀티파트 업로드 완료 실패: %w", err)
+	}
+	return nil
+}
+
+// Abort는 완료하지 않은 업로드를 취소해 이미 올린 파트를 삭제합니다.
+// 취소에 실패해도 버킷 수명 주기 규칙이 미완료 업로드를 정리하므로 로그만 남깁니다.
+func (w *MultipartWriter) Abort() {
+	_, err := w.client.AbortMultipartUpload(context.WithoutCancel(w.ctx), &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(w.bucket),
+		Key:      aws.String(w.key),
+		UploadId: aws.String(w.uploadID),
+	})
+	if err != nil {
+		log.Printf("S3 멀티파트 업로드 취소 실패 (s3://%s/%s): %v", w.bucket, w.key, err)
+	}
+}
+
+func (w *MultipartWriter) uploadPart(body []byte) error {
+	partNumber := int32(len(w.parts) + 1)
+	output, err := w.client.UploadPart(w.ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(w.bucket),
+		Key:        aws.String(w.key),
+		UploadId:   aws.String(w.uploadID),
+		PartNumber: aws.Int32(partNumber),
+		Body:       bytes.NewReader(body),
+	})
+	if err != nil {
+		return fmt.Errorf("S3 파트 %d 업로드 실패: %w", partNumber, err)
+	}
+
+	w.parts = append(w.parts, types.CompletedPart{ETag: output.ETag, PartNumber: aws.Int32(partNumber)})
+	return nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"lambda-go/pkg/models"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// SDK v2에는 presigned POST가 없어 S3 POST 정책을 SigV4로 직접 서명합니다.
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-HTTPPOSTConstructPolicy.html
+const postPolicyAlgorithm = "AWS4-HMAC-SHA256"
+
+// presignPost는 S3 POST 업로드용 URL과 폼 필드를 생성합니다.
+// 정책은 버킷, 서버가 생성한 정확한 키, 정확한 Content-Type, content-length-range(1바이트~MaxFileSize)를 강제하므로
+// PUT과 달리 S3가 크기와 타입을 직접 거부하고, 등록부에 없는 다른 키로는 업로드할 수 없습니다.
+func (s *S3Service) presignPost(ctx context.Context, req *models.PresignedURLRequest, expiresAt time.Time) (string, map[string]string, error) {
+	options := s.s3Client.Options()
+	creds, err := options.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("AWS 자격 증명 조회 실패: %w", err)
+	}
+
+	return signPostPolicy(creds, options.Region, req, time.Now(), expiresAt)
+}
+
+// signPostPolicy는 자격 증명과 리전으로 POST 정책을 만들고 서명합니다.
+// 리전이 없으면 자격 증명 범위와 URL을 만들 수 없으므로 에러를 반환합니다.
+func signPostPolicy(creds aws.Credentials, region string, req *models.PresignedURLRequest, now, expiresAt time.Time) (string, map[string]string, error) {
+	if region == "" {
+		return "", nil, errors.New("S3 리전이 설정되지 않았습니다")
+	}
+
+	now = now.UTC()
+	date := now.Format("20060102")
+	credential := fmt.Sprintf("%s/%s/%s/s3/aws4_request", creds.AccessKeyID, date, region)
+
+	fields := map[string]string{
+		"key":              req.Key,
+		"Content-Type":     req.ContentType,
+		"x-amz-algorithm":  postPolicyAlgorithm,
+		"x-amz-credential": credential,
+		"x-amz-date":       now.Format("20060102T150405Z"),
+	}
+	if creds.SessionToken != "" {
+		fields["x-amz-security-token"] = creds.SessionToken
+	}
+
+	conditions := []interface{}{
+		map[string]string{"bucket": req.Bucket},
+		map[string]string{"key": req.Key},
+		[]interface{}{"content-length-range", 1, req.MaxFileSize},
+	}
+	for _, name := range []string{"Content-Type", "x-amz-algorithm", "x-amz-credential", "x-amz-date", "x-amz-security-token"} {
+		if value, ok := fields[name]; ok {
+			conditions = append(conditions, map[string]string{name: value})
+		}
+	}
+
+	policy, err := json.Marshal(map[string]interface{}{
+		"expiration": expiresAt.UTC().Format("2006-01-02T15:04:05.000Z"),
+		"conditions": conditions,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("POST 정책 생성 실패: %w", err)
+	}
+	fields["policy"] = base64.StdEncoding.EncodeToString(policy)
+
+	signingKey := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	for _, part := range []string{region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	fields["x-amz-signature"] = hex.EncodeToString(hmacSHA256(signingKey, fields["policy"]))
+
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", req.Bucket, region), fields, nil
+}
+
+// hmacSHA256은 key로 data의 HMAC-SHA256을 계산합니다.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"strings"
+
+	"lambda-go/pkg/models"
+	"lambda-go/pkg/utils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+)
+
+// uploadSniffBytes는 실제 파일 형식을 판별할 때 읽는 앞부분 바이트 수입니다 (http.DetectContentType 기준).
+const uploadSniffBytes = 512
+
+// VerifyUpload는 presigned 업로드로 올라온 객체의 앞부분 바이트를 범위 GET으로 읽어 실제 파일 형식을 확인합니다.
+// 실제 형식이 용도에 허용되지 않거나 Content-Type, 확장자와 다르면 격리 접두사로 옮기고 원본을 삭제합니다.
+func (s *S3Service) VerifyUpload(ctx context.Context, bucket, key string) (*models.UploadVerificationResult, error) {
+	result := &models.UploadVerificationResult{Bucket: bucket, Key: key, Status: models.UPLOAD_SKIPPED}
+
+	if strings.HasPrefix(key, s.uploadCfg.QuarantinePrefix) {
+		result.Reason = "격리된 객체입니다"
+		return result, nil
+	}
+	purpose, ok := models.ParseUploadKey(key)
+	if !ok {
+		result.Reason = "presigned 업로드 키가 아닙니다"
+		return result, nil
+	}
+
+	head, contentType, err := s.getObjectHead(ctx, bucket, key)
+	if errors.Is(err, ErrObjectNotFound) {
+		result.Reason = "이미 삭제된 객체입니다"
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.DeclaredType = contentType
+	result.DetectedType = utils.DetectContentType(head)
+
+	policy := models.UploadPurposePolicies[purpose]
+	switch {
+	case len(head) == 0:
+		result.Reason = "빈 파일입니다"
+	case !policy.Allows(result.DetectedType):
+		result.Reason = fmt.Sprintf("%s 용도에 허용되지 않는 파일 형식입니다", purpose)
+	case result.DetectedType != result.DeclaredType:
+		result.Reason = "실제 파일 형식이 Content-Type과 다릅니다"
+	case result.DetectedType != utils.GetMimeTypeFromExtension(strings.ToLower(utils.GetFileExtension(key))):
+		result.Reason = "실제 파일 형식이 확장자와 다릅니다"
+	default:
+		result.Status = models.UPLOAD_VERIFIED
+		return result, nil
+	}
+
+	quarantineKey, err := s.quarantine(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("업로드 격리 (s3://%s/%s → %s): %s (선언 %s, 실제 %s)", bucket, key, quarantineKey, result.Reason, result.DeclaredType, result.DetectedType)
+
+	result.Status = models.UPLOAD_QUARANTINED
+	result.QuarantineKey = quarantineKey
+	return result, nil
+}
+
+// getObjectHead는 객체의 앞부분 uploadSniffBytes 바이트와 Content-Type을 읽습니다. 빈 객체는 빈 바이트를 반환합니다.
+func (s *S3Service) getObjectHead(ctx context.Context, bucket, key string) ([]byte, string, error) {
+	output, err := s.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Range:  aws.String(fmt.Sprintf("bytes=0-%d", uploadSniffBytes-1)),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, "", fmt.Errorf("%s: %w", key, ErrObjectNotFound)
+		}
+		// 빈 객체는 범위를 만족할 수 없어 InvalidRange로 실패함
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "InvalidRange" {
+			return []byte{}, "", nil
+		}
+		return nil, "", fmt.Errorf("S3 객체 조회 실패: %w", err)
+	}
+	defer output.Body.Close()
+
+	head, err := io.ReadAll(io.LimitReader(output.Body, uploadSniffBytes))
+	if err != nil {
+		return nil, "", fmt.Errorf("S3 객체 읽기 실패: %w", err)
+	}
+	return head, aws.ToString(output.ContentType), nil
+}
+
+// quarantine은 객체를 같은 버킷의 격리 접두사 아래로 복사한 뒤 원본을 삭제합니다.
+// 복사본은 ACL을 복사하지 않으므로 비공개로 남습니다.
+func (s *S3Service) quarantine(ctx context.Context, bucket, key string) (string, error) {
+	quarantineKey := s.uploadCfg.QuarantinePrefix + key
+
+	_, err := s.s3Client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(bucket),
+		Key:        aws.String(quarantineKey),
+		CopySource: aws.String((&url.URL{Path: bucket + "/" + key}).EscapedPath()),
+	})
+	if err != nil {
+		return "", fmt.Errorf("격리 객체 복사 실패: %w", err)
+	}
+
+	if err := s.DeleteObject(ctx, bucket, key); err != nil {
+		return "", err
+	}
+	return quarantineKey, nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	config "lambda-go/pkg/configs"
+	"lambda-go/pkg/models"
+	repository "lambda-go/pkg/repositories"
+	"lambda-go/pkg/utils"
+)
+
+// UploadService는 presigned 업로드 등록, 확인, 정리를 처리합니다.
+type UploadService struct {
+	uploadCfg  *config.UploadConfig
+	uploadRepo *repository.UploadRepository
+	s3Service  *S3Service
+}
+
+// NewUploadService는 새 UploadService 인스턴스를 생성합니다.
+func NewUploadService(uploadCfg *config.UploadConfig, uploadRepo *repository.UploadRepository, s3Svc *S3Service) *UploadService {
+	return &UploadService{
+		uploadCfg:  uploadCfg,
+		uploadRepo: uploadRepo,
+		s3Service:  s3Svc,
+	}
+}
+
+// Register는 발급한 업로드 URL을 등록부에 기록하고 응답에 업로드 ID를 넣습니다.
+func (s *UploadService) Register(ctx context.Context, userID string, req *models.PresignedURLRequest, resp *models.PresignedURLResponse) error {
+	id, err := utils.NewUUID()
+	if err != nil {
+		return utils.InternalServerError("업로드 ID 생성 실패", err)
+	}
+
+	upload := &models.Upload{
+		ID:          id,
+		UserID:      userID,
+		Purpose:     req.Purpose,
+		Bucket:      req.Bucket,
+		Key:         req.Key,
+		ContentType: req.ContentType,
+		MaxFileSize: req.MaxFileSize,
+	}
+	if err := s.uploadRepo.CreateUpload(ctx, upload); err != nil {
+		return utils.InternalServerError("업로드 등록 실패", err)
+	}
+
+	resp.UploadID = id
+	return nil
+}
+
+// Confirm은 업로드한 객체를 HEAD로 확인하고 크기와 ETag를 기록합니다.
+// 본인 업로드만 확인할 수 있으며(관리자 제외), 크기나 Content-Type이 발급 조건과 다르면 객체를 삭제하고 400을 반환합니다.
+func (s *UploadService) Confirm(ctx context.Context, uploadID, userID string, role models.Role) (*models.Upload, error) {
+	upload, err := s.uploadRepo.GetUpload(ctx, uploadID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, utils.NotFound("업로드를 찾을 수 없습니다", err)
+		}
+		return nil, utils.InternalServerError("업로드 조회 실패", err)
+	}
+	// 다른 사용자의 업로드는 존재 여부도 알리지 않음
+	if upload.UserID != userID && role != models.ADMIN {
+		return nil, utils.NotFound("업로드를 찾을 수 없습니다")
+	}
+	if upload.Status == models.UPLOAD_DELETED {
+		return nil, utils.Conflict("이미 삭제된 업로드입니다")
+	}
+
+	info, err := s.s3Service.HeadObject(ctx, upload.Bucket, upload.Key)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return nil, utils.Conflict("업로드한 파일이 없습니다. 업로드가 끝나지 않았거나 검증에 실패해 격리되었습니다", err)
+		}
+		return nil, utils.InternalServerError("업로드 파일 확인 실패", err)
+	}
+
+	var violation string
+	switch {
+	case info.Size > upload.MaxFileSize:
+		violation = fmt.Sprintf("최대 파일 크기(%d바이트)를 넘습니다", upload.MaxFileSize)
+	case info.ContentType != upload.ContentType:
+		violation = fmt.Sprintf("Content-Type %s가 발급 시 %s와 다릅니다", info.ContentType, upload.ContentType)
+	}
+	if violation != "" {
+		if err := s.delete(ctx, upload, violation); err != nil {
+			return nil, utils.InternalServerError("조건에 맞지 않는 업로드 삭제 실패", err)
+		}
+		return nil, utils.BadRequest(violation)
+	}
+
+	confirmed, err := s.uploadRepo.ConfirmUpload(ctx, upload.ID, info.Size, info.ETag)
+	if err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, utils.Conflict("이미 삭제된 업로드입니다", err)
+		}
+		return nil, utils.InternalServerError("업로드 확인 기록 실패", err)
+	}
+	return confirmed, nil
+}
+
+// Cleanup은 TTL이 지나도록 확인하지 않았거나, 확인 후 TTL이 지나도록 참조하지 않는 업로드 객체를 한 배치 삭제합니다.
+// 참조 중인 객체는 확인 여부와 관계없이 삭제하지 않습니다.
+func (s *UploadService) Cleanup(ctx context.Context) (models.UploadCleanupResult, error) {
+	var result models.UploadCleanupResult
+
+	// 발급한 URL이 아직 유효한 동안에는 업로드 중일 수 있으므로 URL 최대 유효 기간보다 먼저 정리하지 않음
+	pendingTTL := s.uploadCfg.PendingTTL
+	if pendingTTL < s.uploadCfg.MaxURLExpiry {
+		pendingTTL = s.uploadCfg.MaxURLExpiry
+	}
+
+	now := time.Now()
+	uploads, err := s.uploadRepo.FindCleanupCandidates(ctx, now.Add(-pendingTTL), now.Add(-s.uploadCfg.UnreferencedTTL), s.uploadCfg.CleanupBatchSize)
+	if err != nil {
+		return result, err
+	}
+	result.Scanned = len(uploads)
+
+	for i := range uploads {
+		upload := &uploads[i]
+
+		reason := "확인되지 않은 업로드"
+		if upload.Status == models.UPLOAD_CONFIRMED {
+			reason = "참조되지 않는 업로드"
+		}
+
+		if err := s.delete(ctx, upload, reason); err != nil {
+			log.Printf("업로드 %s(s3://%s/%s) 정리 실패: %v", upload.ID, upload.Bucket, upload.Key, err)
+			result.Failed++
+			continue
+		}
+
+		if upload.Status == models.UPLOAD_CONFIRMED {
+			result.Unreferenced++
+		} else {
+			result.Unconfirmed++
+		}
+	}
+
+	return result, nil
+}
+
+// delete는 업로드 객체를 삭제하고 등록부에 삭제 사유를 기록합니다.
+func (s *UploadService) delete(ctx context.Context, upload *models.Upload, reason string) error {
+	if err := s.s3Service.DeleteObject(ctx, upload.Bucket, upload.Key); err != nil {
+		return err
+	}
+	return s.uploadRepo.MarkUploadDeleted(ctx, upload.ID, reason)
+}
@@ -1,5 +1,10 @@
 package utils
 
+import (
+	"net/http"
+	"strings"
+)
+
 // 파일 확장자 추출 함수
 func GetFileExtension(filename string) string {
 	for i := len(filename) - 1; i >= 0; i-- {
@@ -11,6 +16,7 @@ func GetFileExtension(filename string) string {
 }
 
 // 파일 확장자로부터 MIME 타입 유추 함수
+// 브라우저에서 스크립트를 실행할 수 있는 html, js, svg는 매핑하지 않습니다 (application/octet-stream)
 func GetMimeTypeFromExtension(ext string) string {
 	switch ext {
 	case "jpg", "jpeg":
@@ -21,24 +27,24 @@ func GetMimeTypeFromExtension(ext string) string {
 		return "image/gif"
 	case "webp":
 		return "image/webp"
-	case "svg":
-		return "image/svg+xml"
 	case "pdf":
 		return "application/pdf"
-	case "doc", "docx":
+	case "doc":
 		return "application/msword"
-	case "xls", "xlsx":
+	case "docx":
+		return "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	case "xls":
 		return "application/vnd.ms-excel"
-	case "ppt", "pptx":
+	case "xlsx":
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case "ppt":
 		return "application/vnd.ms-powerpoint"
+	case "pptx":
+		return "application/vnd.openxmlformats-officedocument.presentationml.presentation"
 	case "txt":
 		return "text/plain"
-	case "html", "htm":
-		return "text/html"
 	case "css":
 		return "text/css"
-	case "js":
-		return "application/javascript"
 	case "json":
 		return "application/json"
 	case "xml":
@@ -60,4 +66,14 @@ func GetMimeTypeFromExtension(ext string) string {
 	default:
 		return "application/octet-stream"
 	}
-} 
\ No newline at end of file
+} 
+
+// DetectContentType는 파일 앞부분 바이트(최대 512바이트)로 실제 MIME 타입을 판별합니다.
+// "text/plain; charset=utf-8"처럼 붙는 파라미터는 제거합니다.
+func DetectContentType(head []byte) string {
+	contentType := http.DetectContentType(head)
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return contentType
+}
@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// NewUUID는 임의의 UUID(v4) 문자열을 생성합니다.
+func NewUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("UUID 생성 실패: %w", err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 // 버전 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 변형
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,42 @@
+package utils
+
+import "strings"
+
+// businessNumberWeights는 사업자등록번호 검증번호 계산 가중치입니다.
+var businessNumberWeights = [9]int{1, 3, 7, 1, 3, 7, 1, 3, 5}
+
+// NormalizeBusinessNumber는 사업자등록번호에서 하이픈과 공백을 제거합니다.
+func NormalizeBusinessNumber(number string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(number))
+}
+
+// IsValidBusinessNumber는 사업자등록번호(XXX-XX-XXXXX)의 형식과 검증번호를 확인합니다.
+// 하이픈은 있어도 되고 없어도 됩니다.
+func IsValidBusinessNumber(number string) bool {
+	number = NormalizeBusinessNumber(number)
+	if len(number) != 10 {
+		return false
+	}
+
+	var digits [10]int
+	for i, r := range number {
+		if r < '0' || r > '9' {
+			return false
+		}
+		digits[i] = int(r - '0')
+	}
+
+	sum := 0
+	for i, weight := range businessNumberWeights {
+		sum += digits[i] * weight
+	}
+	// 9번째 자리는 가중치 5를 곱한 값의 십의 자리를 한 번 더 더함
+	sum += digits[8] * 5 / 10
+
+	return (10-sum%10)%10 == digits[9]
+}
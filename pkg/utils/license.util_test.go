@@ -0,0 +1,37 @@
+package utils
+
+import "testing"
+
+func TestIsValidBusinessNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		want   bool
+	}{
+		{"유효", "2208162517", true},
+		{"유효 하이픈", "220-81-62517", true},
+		{"유효 공백", " 124 81 00998 ", true},
+		{"9번째 자리 십의 자리 가산", "1234567891", true},
+		{"검증번호 불일치", "2208162518", false},
+		{"검증번호 불일치 하이픈", "123-45-67890", false},
+		{"자릿수 부족", "220816251", false},
+		{"자릿수 초과", "22081625170", false},
+		{"숫자 아님", "22081625a7", false},
+		{"전각 숫자", "２２０８１６２５１７", false},
+		{"빈 값", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidBusinessNumber(tt.number); got != tt.want {
+				t.Errorf("IsValidBusinessNumber(%q) = %v, want %v", tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeBusinessNumber(t *testing.T) {
+	if got := NormalizeBusinessNumber(" 220-81 62517 "); got != "2208162517" {
+		t.Errorf("NormalizeBusinessNumber() = %q, want %q", got, "2208162517")
+	}
+}
@@ -0,0 +1,31 @@
+package utils
+
+import "strings"
+
+// NormalizePhoneNumber는 전화번호에서 숫자만 남기고 국가번호(+82)를 국내 번호로 바꿉니다.
+func NormalizePhoneNumber(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+
+	if strings.HasPrefix(digits, "82") && len(digits) > 2 && digits[2] != '0' {
+		digits = "0" + digits[2:]
+	}
+	return digits
+}
+
+// IsValidPhoneNumber는 국내 전화번호(0으로 시작하는 9~11자리) 형식인지 확인합니다.
+// 하이픈, 공백, 국가번호(+82)는 허용합니다.
+func IsValidPhoneNumber(phone string) bool {
+	for _, r := range phone {
+		if !(r >= '0' && r <= '9') && !strings.ContainsRune("+- ()", r) {
+			return false
+		}
+	}
+
+	digits := NormalizePhoneNumber(phone)
+	return len(digits) >= 9 && len(digits) <= 11 && digits[0] == '0'
+}
@@ -119,6 +119,18 @@ func NotFound(message string, err ...error) *AppError {
     }
 }
 
+func Conflict(message string, err ...error) *AppError {
+    var original error
+    if len(err) > 0 {
+        original = err[0]
+    }
+    return &AppError{
+        StatusCode: http.StatusConflict,
+        Message:    message,
+        Err:        original,
+    }
+}
+
 func InternalServerError(message string, err ...error) *AppError {
     var original error
     if len(err) > 0 {
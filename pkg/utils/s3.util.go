@@ -0,0 +1,34 @@
+package utils
+
+import "strings"
+
+// IsSafeObjectKey는 S3 키가 경로 조작 없이 안전한지 확인합니다.
+// 절대 경로, 역슬래시, 빈 경로 구간(//), "."/".." 구간, 제어 문자를 포함한 키는 거부합니다.
+func IsSafeObjectKey(key string) bool {
+	if key == "" || len(key) > 1024 || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+
+	for _, r := range key {
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		if segment == "." || segment == ".." {
+			return false
+		}
+		// 마지막 구간만 비어 있을 수 있음 (폴더 형태의 키)
+		if segment == "" && i != len(segments)-1 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSafeUserID는 사용자 ID를 키 접두사에 넣어도 안전한지 확인합니다.
+func IsSafeUserID(userID string) bool {
+	return userID != "" && !strings.ContainsAny(userID, "/\\") && IsSafeObjectKey(userID)
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsSafeObjectKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want bool
+	}{
+		{"일반 키", "restaurants/u1/menu/a.png", true},
+		{"폴더 형태 키", "restaurants/u1/", true},
+		{"한글 파일명", "users/u1/사진.jpg", true},
+		{"빈 키", "", false},
+		{"너무 긴 키", strings.Repeat("a", 1025), false},
+		{"선행 슬래시", "/restaurants/u1/a.png", false},
+		{"역슬래시", "restaurants\\u1\\a.png", false},
+		{"상위 경로 구간", "restaurants/u1/../u2/a.png", false},
+		{"상위 경로로 시작", "../a.png", false},
+		{"상위 경로로 끝남", "restaurants/..", false},
+		{"현재 경로 구간", "restaurants/./u1/a.png", false},
+		{"빈 중간 구간", "restaurants//u1/a.png", false},
+		{"점 두 개가 들어간 파일명", "restaurants/u1/a..png", true},
+		{"개행 문자", "restaurants/u1/a\n.png", false},
+		{"NUL 문자", "restaurants/u1/a\x00.png", false},
+		{"DEL 문자", "restaurants/u1/a\x7f.png", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSafeObjectKey(tt.key); got != tt.want {
+				t.Errorf("IsSafeObjectKey(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSafeUserID(t *testing.T) {
+	tests := []struct {
+		userID string
+		want   bool
+	}{
+		{"u1", true},
+		{"0b9f3a52-6c1d-4e8a-9d7b-3f2e1c4a5b6d", true},
+		{"", false},
+		{"u1/u2", false},
+		{"u1\\u2", false},
+		{"..", false},
+		{".", false},
+		{"u1\n", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsSafeUserID(tt.userID); got != tt.want {
+			t.Errorf("IsSafeUserID(%q) = %v, want %v", tt.userID, got, tt.want)
+		}
+	}
+}
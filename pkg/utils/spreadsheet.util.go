@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// SpreadsheetWriter는 제목 행 다음에 데이터 행을 한 줄씩 이어 쓰는 스프레드시트 작성기입니다.
+// 모든 행을 메모리에 모으지 않고 w로 바로 내보내므로 큰 파일도 일정한 메모리로 만들 수 있습니다.
+type SpreadsheetWriter interface {
+	WriteRow(values []string) error
+	// Close는 남은 내용을 모두 내보냅니다. 내부 io.Writer는 닫지 않습니다.
+	Close() error
+}
+
+// CSVWriter는 CSV 스프레드시트 작성기입니다.
+type CSVWriter struct {
+	writer *csv.Writer
+}
+
+// NewCSVWriter는 w에 UTF-8 BOM과 제목 행을 쓰고 CSV 작성기를 반환합니다.
+// Excel에서 한글이 깨지지 않도록 BOM을 붙이고, 수식으로 해석될 수 있는 값은 작은따옴표로 시작하게 합니다.
+func NewCSVWriter(w io.Writer, headers []string) (*CSVWriter, error) {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return nil, err
+	}
+
+	writer := &CSVWriter{writer: csv.NewWriter(w)}
+	if err := writer.WriteRow(headers); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// WriteRow는 데이터 행 하나를 씁니다.
+func (c *CSVWriter) WriteRow(values []string) error {
+	escaped := make([]string, len(values))
+	for i, value := range values {
+		escaped[i] = escapeFormula(value)
+	}
+	return c.writer.Write(escaped)
+}
+
+// Close는 버퍼에 남은 행을 내보냅니다.
+func (c *CSVWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// WriteCSV는 제목 행과 데이터 행을 CSV로 작성합니다.
+func WriteCSV(headers []string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := NewCSVWriter(&buf, headers)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeSpreadsheetRows(writer, rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// escapeFormula는 스프레드시트 수식 주입을 막기 위해 수식 시작 문자로 시작하는 값 앞에 작은따옴표를 붙입니다.
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// xlsxStaticParts는 시트 하나짜리 통합 문서의 고정 구성 파일입니다.
+var xlsxStaticParts = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`},
+}
+
+// XLSXWriter는 시트 하나짜리 XLSX 통합 문서 작성기입니다. 모든 값은 텍스트 셀로 저장합니다.
+type XLSXWriter struct {
+	archive   *zip.Writer
+	sheet     io.Writer
+	rowNumber int
+}
+
+// NewXLSXWriter는 w에 통합 문서 구성 파일과 시트의 제목 행을 쓰고 XLSX 작성기를 반환합니다.
+// 시트는 zip 마지막 항목이므로 이후 행은 압축되어 w로 바로 이어집니다.
+func NewXLSXWriter(w io.Writer, sheetName string, headers []string) (*XLSXWriter, error) {
+	archive := zip.NewWriter(w)
+
+	for _, part := range xlsxStaticParts {
+		if err := writeZipEntry(archive, part.name, []byte(part.content)); err != nil {
+			return nil, err
+		}
+	}
+
+	var workbook bytes.Buffer
+	workbook.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	workbook.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`)
+	xml.EscapeText(&workbook, []byte(sheetName))
+	workbook.WriteString(`" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+	if err := writeZipEntry(archive, "xl/workbook.xml", workbook.Bytes()); err != nil {
+		return nil, err
+	}
+
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(sheet, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`+"\n"+
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`); err != nil {
+		return nil, err
+	}
+
+	writer := &XLSXWriter{archive: archive, sheet: sheet}
+	if err := writer.WriteRow(headers); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// WriteRow는 데이터 행 하나를 씁니다.
+func (x *XLSXWriter) WriteRow(values []string) error {
+	x.rowNumber++
+	var row bytes.Buffer
+	writeXLSXRow(&row, x.rowNumber, values)
+	_, err := x.sheet.Write(row.Bytes())
+	return err
+}
+
+// Close는 시트를 닫고 zip 중앙 디렉터리를 씁니다.
+func (x *XLSXWriter) Close() error {
+	if _, err := io.WriteString(x.sheet, `</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	return x.archive.Close()
+}
+
+// WriteXLSX는 제목 행과 데이터 행을 시트 하나짜리 XLSX 통합 문서로 작성합니다.
+func WriteXLSX(sheetName string, headers []string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := NewXLSXWriter(&buf, sheetName, headers)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeSpreadsheetRows(writer, rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeSpreadsheetRows는 모든 행을 쓰고 작성기를 닫습니다.
+func writeSpreadsheetRows(writer SpreadsheetWriter, rows [][]string) error {
+	for _, row := range rows {
+		if err := writer.WriteRow(row); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+func writeZipEntry(archive *zip.Writer, name string, content []byte) error {
+	writer, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(content)
+	return err
+}
+
+// writeXLSXRow는 값을 인라인 문자열 셀로 작성합니다. 빈 값은 셀을 생략합니다.
+func writeXLSXRow(buf *bytes.Buffer, rowNumber int, values []string) {
+	fmt.Fprintf(buf, `<row r="%d">`, rowNumber)
+	for i, value := range values {
+		if value == "" {
+			continue
+		}
+		fmt.Fprintf(buf, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">`, xlsxColumnName(i), rowNumber)
+		xml.EscapeText(buf, []byte(value))
+		buf.WriteString(`</t></is></c>`)
+	}
+	buf.WriteString(`</row>`)
+}
+
+// xlsxColumnName은 0부터 시작하는 열 번호를 A, B, ..., Z, AA 형식의 열 이름으로 변환합니다.
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
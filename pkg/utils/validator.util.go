@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"strings"
 
+	"lambda-go/pkg/models"
+
 	"github.com/go-playground/validator/v10"
 )
 
@@ -22,6 +24,22 @@ func init() {
 		}
 		return name
 	})
+
+	// 사업자등록번호 형식/검증번호 확인
+	validate.RegisterValidation("bizno", func(fl validator.FieldLevel) bool {
+		return IsValidBusinessNumber(fl.Field().String())
+	})
+
+	// 국내 전화번호 형식 확인
+	validate.RegisterValidation("phone", func(fl validator.FieldLevel) bool {
+		return IsValidPhoneNumber(fl.Field().String())
+	})
+
+	// HHmm 형식 시간 확인 (종료 시간의 2400 허용)
+	validate.RegisterValidation("hhmm", func(fl validator.FieldLevel) bool {
+		_, err := models.ParseHHmm(fl.Field().String(), true)
+		return err == nil
+	})
 }
 
 // Validate는 구조체의 유효성을 검증합니다.
@@ -48,6 +66,10 @@ func Validate(data interface{}) error {
 			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 필수입니다", e.Field()))
 		case "oneof":
 			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 %s 중 하나여야 합니다", e.Field(), e.Param()))
+		case "min":
+			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 최소 %s 이상이어야 합니다", e.Field(), e.Param()))
+		case "max":
+			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 최대 %s 이하여야 합니다", e.Field(), e.Param()))
 		case "required_if":
 			params := strings.Split(e.Param(), " ")
 			if len(params) >= 2 {
@@ -55,6 +77,14 @@ func Validate(data interface{}) error {
 			} else {
 				errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 조건에 따라 필수입니다", e.Field()))
 			}
+		case "phone":
+			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 올바른 전화번호가 아닙니다", e.Field()))
+		case "hhmm":
+			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 HHmm 형식의 시간이어야 합니다", e.Field()))
+		case "datetime":
+			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 %s 형식이어야 합니다", e.Field(), e.Param()))
+		case "bizno":
+			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드는 올바른 사업자등록번호가 아닙니다", e.Field()))
 		default:
 			errorMessages = append(errorMessages, fmt.Sprintf("%s 필드가 %s 규칙을 만족하지 않습니다", e.Field(), e.Tag()))
 		}